@@ -11,14 +11,13 @@ import (
 	"time"
 
 	"learningbay24.de/backend/calender"
-	"learningbay24.de/backend/config"
 	"learningbay24.de/backend/course"
 	coursematerial "learningbay24.de/backend/courseMaterial"
 	"learningbay24.de/backend/dbi"
 	"learningbay24.de/backend/exam"
 	"learningbay24.de/backend/models"
+	"learningbay24.de/backend/session"
 
-	"github.com/dgrijalva/jwt-go"
 	"github.com/gin-gonic/gin"
 	log "github.com/sirupsen/logrus"
 	"github.com/volatiletech/null/v8"
@@ -63,6 +62,33 @@ func AuthorizeCourseUser(roleId int) bool {
 	return roleId <= dbi.CourseUserRoleId
 }
 
+// getCourseMembership reads the course from the parameter `id`, checks whether the user is enrolled in it and returns the user's role in it.
+// On failure the response status is set and ok is false.
+func (f *PublicController) getCourseMembership(c *gin.Context) (courseId int, courseRole int, ok bool) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	course_id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return 0, 0, false
+	}
+
+	course_role, err := course.GetCourseRole(f.Database, user_id, course_id)
+	if err != nil {
+		log.Errorf("Unable to get course role: %s", err.Error())
+		c.Status(http.StatusInternalServerError)
+		return 0, 0, false
+	}
+	if !AuthorizeCourseUser(course_role) {
+		log.Infof("User is not authorized")
+		c.Status(http.StatusUnauthorized)
+		return 0, 0, false
+	}
+
+	return course_id, course_role, true
+}
+
 func (f *PublicController) AuthorizeUserHasExam(userId, examId int) (bool, error) {
 	log.Infof("Authorizing exam id: %d with user id: %d", examId, userId)
 	return models.UserHasExamExists(context.Background(), f.Database, userId, examId)
@@ -286,7 +312,12 @@ func (f *PublicController) EnrollUser(c *gin.Context) {
 	}
 
 	_, err = course.EnrollUser(f.Database, user_id, id, newCourse.EnrollKey)
-	if err != nil {
+	var missing *course.MissingPrerequisitesError
+	if errors.As(err, &missing) {
+		log.Infof("Unable to enroll user in course: %s", err.Error())
+		c.IndentedJSON(http.StatusForbidden, err.Error())
+		return
+	} else if err != nil {
 		log.Errorf("Unable to enroll user in course: %s", err.Error())
 		c.IndentedJSON(http.StatusBadRequest, err.Error())
 		return
@@ -380,33 +411,22 @@ func (f *PublicController) Login(c *gin.Context) {
 		return
 	}
 
-	claims := &jwt.MapClaims{
-		"IssuedAt":  time.Now().Unix(),
-		"ExpiresAt": time.Now().Add(time.Hour * 24).Unix(),
-		"data": map[string]string{
-			"id":      strconv.Itoa(id),
-			"role_id": strconv.Itoa(user.RoleID),
-		},
+	s, refresh_token, err := session.Create(f.Database, user.ID, c.Request.UserAgent(), c.ClientIP())
+	if err != nil {
+		log.Errorf("Unable to create session: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
 	}
 
-	// Get signed token with the sercret key
-	token := jwt.NewWithClaims(
-		jwt.SigningMethodHS256,
-		claims)
-
-	secretKey := config.Conf.Secrets.JWTSecret
-
-	tokenString, err := token.SignedString([]byte(secretKey))
+	// Set the cookies and add them to the response header
+	tokens, err := issueTokens(c, user, s, refresh_token)
 	if err != nil {
 		log.Errorf("Unable to sign token: %s", err.Error())
 		c.IndentedJSON(http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	// Set the cookie and add it to the response header
-	c.SetCookie("user_token", tokenString, int((time.Hour * 24).Seconds()), "/", config.Conf.Domain, config.Conf.Secure, true)
-
-	c.Status(http.StatusOK)
+	c.IndentedJSON(http.StatusOK, tokens)
 }
 
 func (f *PublicController) Logout(c *gin.Context) {
@@ -418,7 +438,16 @@ func (f *PublicController) Logout(c *gin.Context) {
 		return
 	}
 
-	c.SetCookie("user_token", "", -1, "/", config.Conf.Domain, config.Conf.Secure, true)
+	user_id := c.MustGet("CookieUserId").(int)
+	session_id := c.MustGet("CookieSessionId").(int)
+	err := session.Revoke(f.Database, session_id, user_id)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Errorf("Unable to revoke session: %s", err.Error())
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	clearTokens(c)
 	c.IndentedJSON(http.StatusOK, "")
 }
 
@@ -517,6 +546,9 @@ func (f *PublicController) UploadMaterial(c *gin.Context) {
 
 		err = coursematerial.CreateMaterial(f.Database, file.Filename, "", user_id, course_id, true, fi, int(file.Size))
 		if err != nil {
+			if rejectUpload(c, err) {
+				return
+			}
 			log.Errorf("Unable to create CourseMaterial: %s", err.Error())
 			c.Status(http.StatusInternalServerError)
 			return
@@ -549,69 +581,126 @@ func (f *PublicController) GetMaterialsFromCourse(c *gin.Context) {
 	}
 
 	type _file struct {
-		ID   int    `json:"id"`
-		Name string `json:"name"`
-		URI  string `json:"uri"`
+		ID         int       `json:"id"`
+		Name       string    `json:"name"`
+		URI        string    `json:"uri"`
+		MimeType   string    `json:"mime_type"`
+		Size       int64     `json:"size"`
+		CreatedAt  time.Time `json:"created_at"`
+		HasPreview bool      `json:"has_preview"`
+	}
+
+	// students don't see files in directories that aren't visible yet
+	var files []*models.File
+	if AuthorizeCourseModerator(course_role) {
+		files, err = coursematerial.GetAllMaterialsFromCourse(f.Database, course_id)
+	} else {
+		files, err = coursematerial.GetVisibleMaterialsFromCourse(f.Database, course_id)
 	}
-
-	files, err := coursematerial.GetAllMaterialsFromCourse(f.Database, course_id)
 	if err != nil {
 		log.Errorf("Unable to get all materials from course: %s", err.Error())
 		c.Status(http.StatusInternalServerError)
 		return
 	}
 
+	previews, err := dbi.HasPreview(f.Database, files)
+	if err != nil {
+		log.Errorf("Unable to get previews of materials: %s", err.Error())
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
 	var _files []_file
 	for _, file := range files {
 		uri := ""
+		mime_type := ""
 		if file.Local == 0 {
 			uri = file.URI
+		} else if file.MimeType.Valid {
+			mime_type = file.MimeType.String
+		} else {
+			mime_type = dbi.DefaultMimeType
 		}
 
-		_files = append(_files, _file{file.ID, file.Name, uri})
+		_files = append(_files, _file{file.ID, file.Name, uri, mime_type, file.Size, file.CreatedAt, previews[file.ID]})
 	}
 
 	c.IndentedJSON(http.StatusOK, _files)
 }
 
 func (f *PublicController) GetMaterialFromCourse(c *gin.Context) {
+	file, ok := f.getVisibleMaterial(c)
+	if !ok {
+		return
+	}
+
+	serveFile(c, file)
+}
+
+// getVisibleMaterial returns the material named by the parameters `id` and `file_id` if the user may see it, otherwise it responds with the matching status
+func (f *PublicController) getVisibleMaterial(c *gin.Context) (*models.File, bool) {
 	user_id := c.MustGet("CookieUserId").(int)
 
 	course_id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		log.Errorf("Unable to convert parameter `id` to int: %s", err.Error())
 		c.Status(http.StatusBadRequest)
-		return
+		return nil, false
 	}
 
 	course_role, err := course.GetCourseRole(f.Database, user_id, course_id)
 	if err != nil {
 		log.Errorf("Unable to get course role: %s", err.Error())
 		c.Status(http.StatusInternalServerError)
-		return
+		return nil, false
 	}
 	if !AuthorizeCourseUser(course_role) {
 		log.Infof("User is not authorized")
 		c.Status(http.StatusUnauthorized)
-		return
+		return nil, false
 	}
 
 	file_id, err := strconv.Atoi(c.Param("file_id"))
 	if err != nil {
 		log.Errorf("Unable to convert parameter `file_id` to int: %s", err.Error())
 		c.Status(http.StatusBadRequest)
-		return
+		return nil, false
 	}
 
-	file, err := coursematerial.GetMaterialFromCourse(f.Database, course_id, file_id)
-	if err != nil {
+	// files of other courses and hidden files are treated as missing
+	file, err := coursematerial.GetMaterialFromCourse(f.Database, course_id, file_id, AuthorizeCourseModerator(course_role))
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Infof("Material with id %d not found in course with id %d", file_id, course_id)
+		c.Status(http.StatusNotFound)
+		return nil, false
+	} else if err != nil {
 		log.Errorf("Unable to get material with id %d from course: %s", file_id, err.Error())
 		c.Status(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	return file, true
+}
+
+// GetMaterialPreview serves the preview image of a material, if one was generated
+func (f *PublicController) GetMaterialPreview(c *gin.Context) {
+	file, ok := f.getVisibleMaterial(c)
+	if !ok {
 		return
 	}
 
-	c.File(file.URI)
-	c.Status(http.StatusOK)
+	uri, err := dbi.GetPreview(f.Database, file)
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Infof("Material with id %d has no preview", file.ID)
+		c.Status(http.StatusNotFound)
+		return
+	} else if err != nil {
+		log.Errorf("Unable to get preview of material with id %d: %s", file.ID, err.Error())
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	servePreview(c, file, uri)
 }
 
 func (f *PublicController) DeleteMaterialFromCourse(c *gin.Context) {
@@ -640,6 +729,7 @@ func (f *PublicController) DeleteMaterialFromCourse(c *gin.Context) {
 	if err != nil {
 		log.Errorf("Unable to delete file with id %d from course with id %d", file_id, course_id)
 		c.Status(http.StatusInternalServerError)
+		return
 	}
 
 	c.Status(http.StatusOK)
@@ -1216,6 +1306,9 @@ func (f *PublicController) UploadExamFile(c *gin.Context) {
 		}
 		err = pCtrl.UploadExamFile(file.Filename, "", user_id, id, true, fi, int(file.Size))
 		if err != nil {
+			if rejectUpload(c, err) {
+				return
+			}
 			log.Errorf("Unable to create ExamFile: %s", err.Error())
 			c.Status(http.StatusBadRequest)
 			return
@@ -1452,8 +1545,7 @@ func (f *PublicController) GetFileFromExam(c *gin.Context) {
 		c.Status(http.StatusInternalServerError)
 		return
 	}
-	c.File(file[0].URI)
-	c.Status(http.StatusOK)
+	serveFile(c, file[0])
 }
 
 func (f *PublicController) SubmitAnswerToExam(c *gin.Context) {
@@ -1504,6 +1596,9 @@ func (f *PublicController) SubmitAnswerToExam(c *gin.Context) {
 		}
 		err = pCtrl.SubmitAnswer(file.Filename, "", examId, userId, true, fi, int(file.Size))
 		if err != nil {
+			if rejectUpload(c, err) {
+				return
+			}
 			log.Errorf("Unable to submit answer: %s", err.Error())
 			c.Status(http.StatusInternalServerError)
 			return
@@ -1624,8 +1719,7 @@ func (f *PublicController) GetFileFromAttendee(c *gin.Context) {
 		c.Status(http.StatusInternalServerError)
 		return
 	}
-	c.File(file.URI)
-	c.Status(http.StatusOK)
+	serveFile(c, file)
 }
 
 func (f *PublicController) GradeAnswer(c *gin.Context) {
@@ -1882,7 +1976,14 @@ func (f *PublicController) CreateSubmission(c *gin.Context) {
 		return
 	}
 
-	id, err := course.CreateSubmission(f.Database, name, deadline, course_id, max_filesize, visible_from)
+	allowed_file_types, ok := getFileTypes(j)
+	if !ok {
+		log.Error("unable to convert allowed_file_types to a list of strings")
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	id, err := course.CreateSubmission(f.Database, name, deadline, course_id, max_filesize, visible_from, allowed_file_types)
 	if err != nil {
 		log.Errorf("Unable to create submission: %s", err.Error())
 		c.IndentedJSON(http.StatusInternalServerError, err.Error())
@@ -2002,7 +2103,14 @@ func (f *PublicController) EditSubmissionById(c *gin.Context) {
 		return
 	}
 
-	_, err = course.EditSubmission(f.Database, submission_id, name, deadline, max_filesize, visible_from)
+	allowed_file_types, ok := getFileTypes(j)
+	if !ok {
+		log.Error("unable to convert allowed_file_types to a list of strings")
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	_, err = course.EditSubmission(f.Database, submission_id, name, deadline, max_filesize, visible_from, allowed_file_types)
 	if err != nil {
 		log.Errorf("Unable to update submission: %s", err.Error())
 		c.IndentedJSON(http.StatusInternalServerError, err.Error())
@@ -2087,6 +2195,9 @@ func (f *PublicController) CreateSubmissionHasFiles(c *gin.Context) {
 
 		err = course.CreateSubmissionHasFiles(f.Database, submission_id, file.Filename, "", user_id, true, fi, int(file.Size))
 		if err != nil {
+			if rejectUpload(c, err) {
+				return
+			}
 			log.Errorf("Unable to add file to submission: %s", err.Error())
 			c.Status(http.StatusInternalServerError)
 			return
@@ -2314,8 +2425,27 @@ func (f *PublicController) CreateUserSubmissionHasFiles(c *gin.Context) {
 			return
 		}
 	} else {
+		limits, err := course.GetUploadLimitsOfUserSubmission(f.Database, user_submission_id)
+		if err != nil {
+			log.Errorf("Unable to get upload limits of user submission: %s", err.Error())
+			c.Status(http.StatusInternalServerError)
+			return
+		}
+
+		too_large := func() bool { return false }
+		if limits.MaxFilesize != 0 {
+			var ok bool
+			if too_large, ok = limitRequestBody(c, limits.MaxFilesize); !ok {
+				return
+			}
+		}
+
 		file, err := c.FormFile("file")
 		if err != nil {
+			if too_large() {
+				rejectUpload(c, dbi.FileTooLarge(limits.MaxFilesize))
+				return
+			}
 			log.Errorf("No file found in request: %s", err.Error())
 			c.Status(http.StatusBadRequest)
 			return
@@ -2330,6 +2460,9 @@ func (f *PublicController) CreateUserSubmissionHasFiles(c *gin.Context) {
 
 		err = course.CreateUserSubmissionHasFiles(f.Database, user_submission_id, file.Filename, "", user_id, true, fi, int(file.Size))
 		if err != nil {
+			if rejectUpload(c, err) {
+				return
+			}
 			log.Errorf("Unable to add file to user submission: %s", err.Error())
 			c.Status(http.StatusInternalServerError)
 			return
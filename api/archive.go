@@ -0,0 +1,108 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"learningbay24.de/backend/course"
+	coursematerial "learningbay24.de/backend/courseMaterial"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+func (f *PublicController) GetCourseArchive(c *gin.Context) {
+	course_id, course_role, ok := f.getCourseMembership(c)
+	if !ok {
+		return
+	}
+
+	crs, err := course.GetCourse(f.Database, course_id)
+	if err != nil {
+		log.Errorf("Unable to get course: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	entries, err := coursematerial.GetCourseArchive(f.Database, course_id, AuthorizeCourseModerator(course_role))
+	if err != nil {
+		log.Errorf("Unable to get materials from course: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeArchive(c, crs.Name, entries)
+}
+
+func (f *PublicController) GetDirectoryArchive(c *gin.Context) {
+	course_id, course_role, ok := f.getCourseMembership(c)
+	if !ok {
+		return
+	}
+
+	directory_id, err := strconv.Atoi(c.Param("directory_id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `directory_id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	include_hidden := AuthorizeCourseModerator(course_role)
+	directory, err := coursematerial.GetDirectory(f.Database, course_id, directory_id, include_hidden)
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Infof("Directory %d not found in course %d", directory_id, course_id)
+		c.Status(http.StatusNotFound)
+		return
+	} else if err != nil {
+		log.Errorf("Unable to get directory: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	entries, err := coursematerial.GetDirectoryArchive(f.Database, course_id, directory_id, include_hidden)
+	if err != nil {
+		log.Errorf("Unable to get materials from directory: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writeArchive(c, directory.Name, entries)
+}
+
+// writeArchive streams the entries as a ZIP archive named after name.
+// Once streaming has started the status can't be changed anymore, so errors are only logged and the connection is aborted, letting the download fail.
+func writeArchive(c *gin.Context, name string, entries []coursematerial.ArchiveEntry) {
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": name + ".zip"}))
+	c.Header("Content-Type", "application/zip")
+	c.Status(http.StatusOK)
+
+	err := coursematerial.WriteArchive(c.Writer, entries)
+	if err != nil {
+		log.Errorf("Unable to write archive: %s", err.Error())
+		abortConnection(c)
+	}
+}
+
+// key of the context value marking a request whose connection has to be aborted
+const abortConnectionKey = "abort_connection"
+
+// abortConnection marks the request, so AbortMiddleware breaks off its response instead of ending it cleanly
+func abortConnection(c *gin.Context) {
+	c.Set(abortConnectionKey, true)
+	c.Abort()
+}
+
+// AbortMiddleware aborts the connection of requests that failed after their response was started, so clients don't take a truncated response for a complete one.
+// It has to be used before gin's Recovery, which would recover the panic used for this.
+func AbortMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if c.GetBool(abortConnectionKey) {
+			panic(http.ErrAbortHandler)
+		}
+	}
+}
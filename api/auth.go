@@ -0,0 +1,102 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"learningbay24.de/backend/config"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gin-gonic/gin"
+)
+
+// ErrNoToken is returned when a request carries no access token
+var ErrNoToken = errors.New("no access token found in request")
+
+// Identity is whom an access token was issued to
+type Identity struct {
+	UserID    int
+	RoleID    int
+	SessionID int
+}
+
+// accessClaims are the claims of an access token. Expiry and issue time use the standard claims `exp` and `iat`.
+type accessClaims struct {
+	jwt.StandardClaims
+	Data map[string]string `json:"data"`
+}
+
+// signAccessToken returns an access token for a session of a user, which is valid for lifetime
+func signAccessToken(id Identity, lifetime time.Duration) (string, error) {
+	now := time.Now()
+	claims := &accessClaims{
+		StandardClaims: jwt.StandardClaims{
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(lifetime).Unix(),
+		},
+		Data: map[string]string{
+			"id":         strconv.Itoa(id.UserID),
+			"role_id":    strconv.Itoa(id.RoleID),
+			"session_id": strconv.Itoa(id.SessionID),
+		},
+	}
+
+	// Get signed token with the sercret key
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(config.Conf.Secrets.JWTSecret))
+}
+
+// TokenFromRequest returns the access token of a request, sent either as `Authorization: Bearer` header by scripts and apps or as cookie by browsers
+func TokenFromRequest(c *gin.Context) (string, error) {
+	if header := c.GetHeader("Authorization"); header != "" {
+		scheme, token, _ := strings.Cut(header, " ")
+		token = strings.TrimSpace(token)
+		if !strings.EqualFold(scheme, "Bearer") || token == "" {
+			return "", fmt.Errorf("%w: unsupported authorization \"%s\"", ErrNoToken, scheme)
+		}
+		return token, nil
+	}
+
+	token, err := c.Cookie(accessTokenCookie)
+	if err != nil || token == "" {
+		return "", ErrNoToken
+	}
+	return token, nil
+}
+
+// ParseAccessToken verifies the signature of an access token as well as its expiry and issue time and returns whom it was issued to
+func ParseAccessToken(tokenString string) (*Identity, error) {
+	claims := &accessClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+
+		return []byte(config.Conf.Secrets.JWTSecret), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the standard claims are only checked if present, but tokens without them would never expire
+	if claims.ExpiresAt == 0 || claims.IssuedAt == 0 {
+		return nil, errors.New("token is missing `exp` or `iat`")
+	}
+
+	var id Identity
+	fields := []struct {
+		name  string
+		value *int
+	}{{"id", &id.UserID}, {"role_id", &id.RoleID}, {"session_id", &id.SessionID}}
+	for _, f := range fields {
+		*f.value, err = strconv.Atoi(claims.Data[f.name])
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert %s to int: %w", f.name, err)
+		}
+	}
+
+	return &id, nil
+}
@@ -0,0 +1,135 @@
+package api
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"learningbay24.de/backend/config"
+)
+
+func requestContext(headers map[string]string) *gin.Context {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("GET", "/users/courses", nil)
+	for k, v := range headers {
+		c.Request.Header.Set(k, v)
+	}
+	return c
+}
+
+func TestTokenFromRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+		token   string
+	}{
+		{"only cookie", map[string]string{"Cookie": "user_token=abc.def.ghi"}, "abc.def.ghi"},
+		{"other cookies", map[string]string{"Cookie": "theme=dark; user_token=abc.def.ghi; refresh_token=xyz"}, "abc.def.ghi"},
+		{"other cookie with equals signs first", map[string]string{"Cookie": "prefs=a=b; user_token=abc.def.ghi"}, "abc.def.ghi"},
+		{"bearer", map[string]string{"Authorization": "Bearer abc.def.ghi"}, "abc.def.ghi"},
+		{"bearer in lower case", map[string]string{"Authorization": "bearer abc.def.ghi"}, "abc.def.ghi"},
+		{"bearer preferred over cookie", map[string]string{"Authorization": "Bearer abc.def.ghi", "Cookie": "user_token=other"}, "abc.def.ghi"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := TokenFromRequest(requestContext(tt.headers))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.token, token)
+		})
+	}
+}
+
+func TestTokenFromRequestMissing(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+	}{
+		{"nothing", nil},
+		{"other cookies only", map[string]string{"Cookie": "theme=dark; refresh_token=xyz"}},
+		{"empty cookie", map[string]string{"Cookie": "user_token="}},
+		{"cookie without value", map[string]string{"Cookie": "user_token"}},
+		{"basic auth", map[string]string{"Authorization": "Basic dXNlcjpwdw=="}},
+		{"bearer without token", map[string]string{"Authorization": "Bearer "}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NotPanics(t, func() {
+				_, err := TokenFromRequest(requestContext(tt.headers))
+				assert.True(t, errors.Is(err, ErrNoToken))
+			})
+		})
+	}
+}
+
+func signClaims(t *testing.T, method jwt.SigningMethod, key interface{}, claims jwt.Claims) string {
+	token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestParseAccessToken(t *testing.T) {
+	config.Conf.Secrets.JWTSecret = "test secret"
+	secret := []byte(config.Conf.Secrets.JWTSecret)
+	data := map[string]string{"id": "7", "role_id": "3", "session_id": "42"}
+	now := time.Now()
+
+	token, err := signAccessToken(Identity{UserID: 7, RoleID: 3, SessionID: 42}, time.Minute)
+	assert.NoError(t, err)
+	id, err := ParseAccessToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, &Identity{UserID: 7, RoleID: 3, SessionID: 42}, id)
+
+	// the claims use their standard names
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) { return secret, nil })
+	assert.NoError(t, err)
+	assert.Contains(t, claims, "exp")
+	assert.Contains(t, claims, "iat")
+
+	invalid := map[string]string{
+		"expired": signClaims(t, jwt.SigningMethodHS256, secret, &accessClaims{
+			StandardClaims: jwt.StandardClaims{IssuedAt: now.Add(-time.Hour).Unix(), ExpiresAt: now.Add(-time.Minute).Unix()},
+			Data:           data,
+		}),
+		"issued in the future": signClaims(t, jwt.SigningMethodHS256, secret, &accessClaims{
+			StandardClaims: jwt.StandardClaims{IssuedAt: now.Add(time.Hour).Unix(), ExpiresAt: now.Add(2 * time.Hour).Unix()},
+			Data:           data,
+		}),
+		"without exp": signClaims(t, jwt.SigningMethodHS256, secret, &accessClaims{
+			StandardClaims: jwt.StandardClaims{IssuedAt: now.Unix()},
+			Data:           data,
+		}),
+		"with the former custom claims": signClaims(t, jwt.SigningMethodHS256, secret, jwt.MapClaims{
+			"IssuedAt":  now.Unix(),
+			"ExpiresAt": now.Add(time.Hour).Unix(),
+			"data":      data,
+		}),
+		"without session": signClaims(t, jwt.SigningMethodHS256, secret, &accessClaims{
+			StandardClaims: jwt.StandardClaims{IssuedAt: now.Unix(), ExpiresAt: now.Add(time.Hour).Unix()},
+			Data:           map[string]string{"id": "7", "role_id": "3"},
+		}),
+		"signed with another secret": signClaims(t, jwt.SigningMethodHS256, []byte("other secret"), &accessClaims{
+			StandardClaims: jwt.StandardClaims{IssuedAt: now.Unix(), ExpiresAt: now.Add(time.Hour).Unix()},
+			Data:           data,
+		}),
+		"unsigned": signClaims(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, &accessClaims{
+			StandardClaims: jwt.StandardClaims{IssuedAt: now.Unix(), ExpiresAt: now.Add(time.Hour).Unix()},
+			Data:           data,
+		}),
+		"malformed": "abc.def.ghi",
+	}
+	for name, token := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseAccessToken(token)
+			assert.Error(t, err)
+		})
+	}
+}
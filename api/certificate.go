@@ -0,0 +1,96 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"learningbay24.de/backend/certificate"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+func (f *PublicController) GetCertificatesFromUser(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	certificates, err := certificate.GetCertificatesFromUser(f.Database, user_id)
+	if err != nil {
+		log.Errorf("Unable to get certificates of user: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, certificates)
+}
+
+func (f *PublicController) GetCertificate(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+	role_id := c.MustGet("CookieRoleId").(int)
+
+	cert, err := certificate.GetCertificate(f.Database, c.Param("id"))
+	if errors.Is(err, sql.ErrNoRows) {
+		c.Status(http.StatusNotFound)
+		return
+	} else if err != nil {
+		log.Errorf("Unable to get certificate: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if cert.UserID != user_id && !AuthorizeAdmin(role_id) {
+		log.Infof("User is not authorized")
+		c.Status(http.StatusUnauthorized)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, cert)
+}
+
+func (f *PublicController) VerifyCertificate(c *gin.Context) {
+	verification, err := certificate.Verify(f.Database, c.Param("uuid"))
+	if errors.Is(err, sql.ErrNoRows) {
+		c.Status(http.StatusNotFound)
+		return
+	} else if err != nil {
+		log.Errorf("Unable to verify certificate: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, verification)
+}
+
+func (f *PublicController) GetCertificatePDF(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+	role_id := c.MustGet("CookieRoleId").(int)
+
+	cert, err := certificate.GetCertificate(f.Database, c.Param("id"))
+	if errors.Is(err, sql.ErrNoRows) {
+		c.Status(http.StatusNotFound)
+		return
+	} else if err != nil {
+		log.Errorf("Unable to get certificate: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if cert.UserID != user_id && !AuthorizeAdmin(role_id) {
+		log.Infof("User is not authorized")
+		c.Status(http.StatusUnauthorized)
+		return
+	}
+
+	var buf bytes.Buffer
+	err = certificate.WritePDF(f.Database, cert.ID, &buf)
+	if err != nil {
+		log.Errorf("Unable to render certificate: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"certificate-%s.pdf\"", cert.ID))
+	c.Data(http.StatusOK, "application/pdf", buf.Bytes())
+}
@@ -0,0 +1,280 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	coursematerial "learningbay24.de/backend/courseMaterial"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+)
+
+type _directory struct {
+	Name string `json:"name"`
+	// RFC 3339, defaults to now when creating a directory
+	VisibleFrom string `json:"visible_from"`
+}
+
+type _moveMaterial struct {
+	DirectoryID null.Int `json:"directory_id"`
+}
+
+func (f *PublicController) GetDirectoriesFromCourse(c *gin.Context) {
+	course_id, course_role, ok := f.getCourseMembership(c)
+	if !ok {
+		return
+	}
+
+	directories, err := coursematerial.GetDirectoriesFromCourse(f.Database, course_id, AuthorizeCourseModerator(course_role))
+	if err != nil {
+		log.Errorf("Unable to get directories from course: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, directories)
+}
+
+func (f *PublicController) GetMaterialsFromDirectory(c *gin.Context) {
+	course_id, course_role, ok := f.getCourseMembership(c)
+	if !ok {
+		return
+	}
+
+	directory_id, err := strconv.Atoi(c.Param("directory_id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `directory_id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	files, err := coursematerial.GetMaterialsFromDirectory(f.Database, course_id, directory_id, AuthorizeCourseModerator(course_role))
+	if err != nil {
+		log.Errorf("Unable to get materials from directory: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	type _file struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+		URI  string `json:"uri"`
+	}
+
+	_files := []_file{}
+	for _, file := range files {
+		uri := ""
+		if file.Local == 0 {
+			uri = file.URI
+		}
+
+		_files = append(_files, _file{file.ID, file.Name, uri})
+	}
+
+	c.IndentedJSON(http.StatusOK, _files)
+}
+
+func (f *PublicController) CreateDirectory(c *gin.Context) {
+	course_id, ok := f.getModeratedCourse(c)
+	if !ok {
+		return
+	}
+
+	var directory _directory
+	if err := c.BindJSON(&directory); err != nil {
+		log.Errorf("Unable to bind json: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	visible_from := time.Now()
+	if directory.VisibleFrom != "" {
+		t, err := time.Parse(time.RFC3339, directory.VisibleFrom)
+		if err != nil {
+			log.Errorf("Unable to parse visible_from: %s", err.Error())
+			c.IndentedJSON(http.StatusBadRequest, err.Error())
+			return
+		}
+		visible_from = t
+	}
+
+	id, err := coursematerial.CreateDirectory(f.Database, course_id, directory.Name, visible_from)
+	if err != nil {
+		log.Errorf("Unable to create directory: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.IndentedJSON(http.StatusCreated, id)
+}
+
+func (f *PublicController) EditDirectory(c *gin.Context) {
+	course_id, ok := f.getModeratedCourse(c)
+	if !ok {
+		return
+	}
+
+	directory_id, err := strconv.Atoi(c.Param("directory_id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `directory_id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	var directory _directory
+	if err := c.BindJSON(&directory); err != nil {
+		log.Errorf("Unable to bind json: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var visible_from null.Time
+	if directory.VisibleFrom != "" {
+		t, err := time.Parse(time.RFC3339, directory.VisibleFrom)
+		if err != nil {
+			log.Errorf("Unable to parse visible_from: %s", err.Error())
+			c.IndentedJSON(http.StatusBadRequest, err.Error())
+			return
+		}
+		visible_from = null.TimeFrom(t)
+	}
+
+	edited, err := coursematerial.EditDirectory(f.Database, course_id, directory_id, directory.Name, visible_from)
+	if err != nil {
+		log.Errorf("Unable to edit directory: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, edited)
+}
+
+func (f *PublicController) DeleteDirectory(c *gin.Context) {
+	course_id, ok := f.getModeratedCourse(c)
+	if !ok {
+		return
+	}
+
+	directory_id, err := strconv.Atoi(c.Param("directory_id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `directory_id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	err = coursematerial.DeleteDirectory(f.Database, course_id, directory_id)
+	if err != nil {
+		log.Errorf("Unable to delete directory: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (f *PublicController) UploadMaterialToDirectory(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	course_id, ok := f.getModeratedCourse(c)
+	if !ok {
+		return
+	}
+
+	directory_id, err := strconv.Atoi(c.Param("directory_id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `directory_id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	if c.ContentType() == "text/plain" {
+		var file _file
+		if err := c.BindJSON(&file); err != nil {
+			log.Errorf("Unable to bind json: %s", err.Error())
+			c.IndentedJSON(http.StatusBadRequest, err.Error())
+			return
+		}
+
+		err = coursematerial.CreateMaterialInDirectory(f.Database, file.Name, file.Uri, user_id, course_id, directory_id, false, nil, 0)
+		if err != nil {
+			log.Errorf("Unable to create CourseMaterial: %s", err.Error())
+			c.IndentedJSON(http.StatusBadRequest, err.Error())
+			return
+		}
+	} else {
+		file, err := c.FormFile("file")
+		if err != nil {
+			log.Errorf("No file found in request: %s", err.Error())
+			c.Status(http.StatusBadRequest)
+			return
+		}
+
+		fi, err := file.Open()
+		if err != nil {
+			log.Errorf("Unable to open file: %s", err.Error())
+			c.Status(http.StatusInternalServerError)
+			return
+		}
+
+		err = coursematerial.CreateMaterialInDirectory(f.Database, file.Filename, "", user_id, course_id, directory_id, true, fi, int(file.Size))
+		if err != nil {
+			if rejectUpload(c, err) {
+				return
+			}
+			log.Errorf("Unable to create CourseMaterial: %s", err.Error())
+			c.IndentedJSON(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	c.Status(http.StatusCreated)
+}
+
+func (f *PublicController) MoveMaterial(c *gin.Context) {
+	course_id, ok := f.getModeratedCourse(c)
+	if !ok {
+		return
+	}
+
+	file_id, err := strconv.Atoi(c.Param("file_id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `file_id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	var move _moveMaterial
+	if err := c.BindJSON(&move); err != nil {
+		log.Errorf("Unable to bind json: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err = coursematerial.MoveMaterial(f.Database, course_id, file_id, move.DirectoryID)
+	if err != nil {
+		log.Errorf("Unable to move material: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// getModeratedCourse reads the course from the parameter `id` and checks whether the user is a moderator of it.
+// On failure the response status is set and ok is false.
+func (f *PublicController) getModeratedCourse(c *gin.Context) (courseId int, ok bool) {
+	course_id, course_role, ok := f.getCourseMembership(c)
+	if !ok {
+		return 0, false
+	}
+	if !AuthorizeCourseModerator(course_role) {
+		log.Infof("User is not authorized")
+		c.Status(http.StatusUnauthorized)
+		return 0, false
+	}
+
+	return course_id, true
+}
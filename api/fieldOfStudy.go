@@ -0,0 +1,289 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	fieldofstudy "learningbay24.de/backend/fieldOfStudy"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+)
+
+type _fieldOfStudy struct {
+	Name      string   `json:"name"`
+	Semesters null.Int `json:"semesters"`
+}
+
+type _curriculumCourse struct {
+	CourseID int `json:"course_id"`
+	Semester int `json:"semester"`
+}
+
+func (f *PublicController) GetFieldsOfStudy(c *gin.Context) {
+	fields, err := fieldofstudy.GetFieldsOfStudy(f.Database)
+	if err != nil {
+		log.Errorf("Unable to get fields of study: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, fields)
+}
+
+func (f *PublicController) GetFieldOfStudy(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	fos, err := fieldofstudy.GetFieldOfStudy(f.Database, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.Status(http.StatusNotFound)
+		return
+	} else if err != nil {
+		log.Errorf("Unable to get field of study: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, fos)
+}
+
+func (f *PublicController) GetCurriculum(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	curriculum, err := fieldofstudy.GetCurriculum(f.Database, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.Status(http.StatusNotFound)
+		return
+	} else if err != nil {
+		log.Errorf("Unable to get curriculum: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, curriculum)
+}
+
+func (f *PublicController) CreateFieldOfStudy(c *gin.Context) {
+	role_id := c.MustGet("CookieRoleId").(int)
+	if !AuthorizeAdmin(role_id) {
+		log.Infof("User is not authorized")
+		c.Status(http.StatusUnauthorized)
+		return
+	}
+
+	var fos _fieldOfStudy
+	if err := c.BindJSON(&fos); err != nil {
+		log.Errorf("Unable to bind json: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	id, err := fieldofstudy.CreateFieldOfStudy(f.Database, fos.Name, fos.Semesters.Int)
+	if err != nil {
+		log.Errorf("Unable to create field of study: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.IndentedJSON(http.StatusCreated, id)
+}
+
+func (f *PublicController) EditFieldOfStudy(c *gin.Context) {
+	role_id := c.MustGet("CookieRoleId").(int)
+	if !AuthorizeAdmin(role_id) {
+		log.Infof("User is not authorized")
+		c.Status(http.StatusUnauthorized)
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	var fos _fieldOfStudy
+	if err := c.BindJSON(&fos); err != nil {
+		log.Errorf("Unable to bind json: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	edited, err := fieldofstudy.EditFieldOfStudy(f.Database, id, fos.Name, fos.Semesters)
+	if err != nil {
+		log.Errorf("Unable to edit field of study: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, edited)
+}
+
+func (f *PublicController) DeleteFieldOfStudy(c *gin.Context) {
+	role_id := c.MustGet("CookieRoleId").(int)
+	if !AuthorizeAdmin(role_id) {
+		log.Infof("User is not authorized")
+		c.Status(http.StatusUnauthorized)
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	err = fieldofstudy.DeleteFieldOfStudy(f.Database, id)
+	if err != nil {
+		log.Errorf("Unable to delete field of study: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (f *PublicController) SetCurriculumCourse(c *gin.Context) {
+	role_id := c.MustGet("CookieRoleId").(int)
+	if !AuthorizeAdmin(role_id) {
+		log.Infof("User is not authorized")
+		c.Status(http.StatusUnauthorized)
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	var course _curriculumCourse
+	if err := c.BindJSON(&course); err != nil {
+		log.Errorf("Unable to bind json: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err = fieldofstudy.SetCourseSemester(f.Database, id, course.CourseID, course.Semester)
+	if err != nil {
+		log.Errorf("Unable to add course to curriculum: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (f *PublicController) RemoveCurriculumCourse(c *gin.Context) {
+	role_id := c.MustGet("CookieRoleId").(int)
+	if !AuthorizeAdmin(role_id) {
+		log.Infof("User is not authorized")
+		c.Status(http.StatusUnauthorized)
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	course_id, err := strconv.Atoi(c.Param("course_id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `course_id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	err = fieldofstudy.RemoveCourse(f.Database, id, course_id)
+	if err != nil {
+		log.Errorf("Unable to remove course from curriculum: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (f *PublicController) GetFieldsOfStudyFromUser(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	fields, err := fieldofstudy.GetFieldsOfStudyFromUser(f.Database, user_id)
+	if err != nil {
+		log.Errorf("Unable to get fields of study from user: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, fields)
+}
+
+func (f *PublicController) EnrollInFieldOfStudy(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	err = fieldofstudy.EnrollUser(f.Database, user_id, id)
+	if err != nil {
+		log.Errorf("Unable to enroll user in field of study: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (f *PublicController) UnenrollFromFieldOfStudy(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	err = fieldofstudy.UnenrollUser(f.Database, user_id, id)
+	if err != nil {
+		log.Errorf("Unable to remove user from field of study: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (f *PublicController) GetProgressFromUser(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	progress, err := fieldofstudy.GetProgress(f.Database, user_id)
+	if err != nil {
+		log.Errorf("Unable to get progress from user: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, progress)
+}
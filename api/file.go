@@ -0,0 +1,82 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+
+	"learningbay24.de/backend/models"
+	"learningbay24.de/backend/preview"
+	"learningbay24.de/backend/storage"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+// fileETag returns the entity tag of a file's content. The content of a file never changes, so the hash of the content or else the ID of the file identifies it.
+func fileETag(file *models.File) string {
+	if file.Hash.Valid {
+		return fmt.Sprintf("\"%s\"", file.Hash.String)
+	}
+	return fmt.Sprintf("\"file-%d\"", file.ID)
+}
+
+// serveFile streams the content of a file from its storage backend, remote files are redirected to.
+// Range requests are answered with the requested parts only and conditional requests with 304 if the client's copy is still current.
+func serveFile(c *gin.Context, file *models.File) {
+	if file.Local == 0 {
+		c.Redirect(http.StatusFound, file.URI)
+		return
+	}
+
+	content, ok := getContent(c, file, file.URI)
+	if !ok {
+		return
+	}
+	defer content.Close()
+
+	c.Header("ETag", fileETag(file))
+	// files are only served to authorized users, so shared caches must not keep them and clients have to revalidate
+	c.Header("Cache-Control", "private, no-cache")
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": file.Name}))
+	c.Header("X-Content-Type-Options", "nosniff")
+
+	http.ServeContent(c.Writer, c.Request, file.Name, file.CreatedAt, content)
+}
+
+// servePreview streams the preview image of a file stored under uri, to be displayed inline
+func servePreview(c *gin.Context, file *models.File, uri string) {
+	content, ok := getContent(c, file, uri)
+	if !ok {
+		return
+	}
+	defer content.Close()
+
+	// previews are generated once per content, like the content they never change
+	c.Header("ETag", fmt.Sprintf("\"preview-%s\"", file.Hash.String))
+	c.Header("Cache-Control", "private, no-cache")
+	c.Header("Content-Type", preview.MimeType)
+	c.Header("Content-Disposition", "inline")
+	c.Header("X-Content-Type-Options", "nosniff")
+
+	http.ServeContent(c.Writer, c.Request, "", time.Time{}, content)
+}
+
+// getContent returns the content of a file or its preview stored under uri, otherwise it responds with 404 if it is missing or 500
+func getContent(c *gin.Context, file *models.File, uri string) (io.ReadSeekCloser, bool) {
+	content, err := storage.Get(c.Request.Context(), uri)
+	if errors.Is(err, storage.ErrNotExist) {
+		log.Errorf("Content %s of file %d is missing: %s", uri, file.ID, err.Error())
+		c.Status(http.StatusNotFound)
+		return nil, false
+	} else if err != nil {
+		log.Errorf("Unable to get content of file: %s", err.Error())
+		c.Status(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	return content, true
+}
@@ -0,0 +1,325 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"learningbay24.de/backend/forum"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+type _forumEntry struct {
+	Subject string `json:"subject"`
+	Content string `json:"content"`
+}
+
+type _forumModeration struct {
+	Pinned bool   `json:"pinned"`
+	Locked bool   `json:"locked"`
+	Hidden bool   `json:"hidden"`
+	Reason string `json:"reason"`
+}
+
+// getForumFromCourse reads the course from the parameter `id`, checks whether the user is enrolled in it and returns the ID of the course's forum.
+// On failure the response status is set and ok is false.
+func (f *PublicController) getForumFromCourse(c *gin.Context) (forumId int, courseRole int, ok bool) {
+	course_id, course_role, ok := f.getCourseMembership(c)
+	if !ok {
+		return 0, 0, false
+	}
+
+	forum_id, err := forum.GetForumIdFromCourse(f.Database, course_id)
+	if err != nil {
+		log.Errorf("Unable to get forum of course: %s", err.Error())
+		c.Status(http.StatusInternalServerError)
+		return 0, 0, false
+	}
+
+	return forum_id, course_role, true
+}
+
+func (f *PublicController) GetForumThreads(c *gin.Context) {
+	forum_id, course_role, ok := f.getForumFromCourse(c)
+	if !ok {
+		return
+	}
+
+	threads, err := forum.GetThreads(f.Database, forum_id)
+	if err != nil {
+		log.Errorf("Unable to get threads from forum: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+	if !AuthorizeCourseModerator(course_role) {
+		forum.RedactHidden(threads...)
+	}
+
+	c.IndentedJSON(http.StatusOK, threads)
+}
+
+func (f *PublicController) GetForumThread(c *gin.Context) {
+	forum_id, course_role, ok := f.getForumFromCourse(c)
+	if !ok {
+		return
+	}
+
+	entry_id, err := strconv.Atoi(c.Param("entry_id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `entry_id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	thread, err := forum.GetThread(f.Database, forum_id, entry_id)
+	if err != nil {
+		log.Errorf("Unable to get thread from forum: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+	if !AuthorizeCourseModerator(course_role) {
+		forum.RedactHidden(thread.ForumEntry)
+		forum.RedactHidden(thread.Replies...)
+	}
+
+	c.IndentedJSON(http.StatusOK, thread)
+}
+
+func (f *PublicController) CreateForumThread(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	forum_id, _, ok := f.getForumFromCourse(c)
+	if !ok {
+		return
+	}
+
+	var entry _forumEntry
+	if err := c.BindJSON(&entry); err != nil {
+		log.Errorf("Unable to bind json: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	id, err := forum.CreateThread(f.Database, forum_id, user_id, entry.Subject, entry.Content)
+	if err != nil {
+		log.Errorf("Unable to create thread: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.IndentedJSON(http.StatusCreated, id)
+}
+
+func (f *PublicController) ReplyToForumEntry(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	forum_id, _, ok := f.getForumFromCourse(c)
+	if !ok {
+		return
+	}
+
+	entry_id, err := strconv.Atoi(c.Param("entry_id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `entry_id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	var entry _forumEntry
+	if err := c.BindJSON(&entry); err != nil {
+		log.Errorf("Unable to bind json: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	id, err := forum.CreateReply(f.Database, forum_id, entry_id, user_id, entry.Subject, entry.Content)
+	if err != nil {
+		log.Errorf("Unable to reply to forum entry: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.IndentedJSON(http.StatusCreated, id)
+}
+
+func (f *PublicController) EditForumEntry(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	forum_id, _, ok := f.getForumFromCourse(c)
+	if !ok {
+		return
+	}
+
+	entry_id, err := strconv.Atoi(c.Param("entry_id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `entry_id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	var entry _forumEntry
+	if err := c.BindJSON(&entry); err != nil {
+		log.Errorf("Unable to bind json: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	edited, err := forum.EditEntry(f.Database, forum_id, entry_id, user_id, entry.Subject, entry.Content)
+	if err != nil {
+		log.Errorf("Unable to edit forum entry: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, edited)
+}
+
+func (f *PublicController) DeleteForumEntry(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	forum_id, _, ok := f.getForumFromCourse(c)
+	if !ok {
+		return
+	}
+
+	entry_id, err := strconv.Atoi(c.Param("entry_id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `entry_id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	err = forum.DeleteEntry(f.Database, forum_id, entry_id, user_id)
+	if err != nil {
+		log.Errorf("Unable to delete forum entry: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// getModeratedEntry reads the forum from the course and the entry from the parameter `entry_id` and checks whether the user is a moderator of the course.
+// On failure the response status is set and ok is false.
+func (f *PublicController) getModeratedEntry(c *gin.Context) (forumId int, entryId int, ok bool) {
+	forum_id, course_role, ok := f.getForumFromCourse(c)
+	if !ok {
+		return 0, 0, false
+	}
+	if !AuthorizeCourseModerator(course_role) {
+		log.Infof("User is not authorized")
+		c.Status(http.StatusUnauthorized)
+		return 0, 0, false
+	}
+
+	entry_id, err := strconv.Atoi(c.Param("entry_id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `entry_id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return 0, 0, false
+	}
+
+	return forum_id, entry_id, true
+}
+
+func (f *PublicController) PinForumThread(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	forum_id, entry_id, ok := f.getModeratedEntry(c)
+	if !ok {
+		return
+	}
+
+	var m _forumModeration
+	if err := c.BindJSON(&m); err != nil {
+		log.Errorf("Unable to bind json: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := forum.SetPinned(f.Database, forum_id, entry_id, user_id, m.Pinned)
+	if err != nil {
+		log.Errorf("Unable to pin forum thread: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (f *PublicController) LockForumThread(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	forum_id, entry_id, ok := f.getModeratedEntry(c)
+	if !ok {
+		return
+	}
+
+	var m _forumModeration
+	if err := c.BindJSON(&m); err != nil {
+		log.Errorf("Unable to bind json: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := forum.SetLocked(f.Database, forum_id, entry_id, user_id, m.Locked)
+	if err != nil {
+		log.Errorf("Unable to lock forum thread: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (f *PublicController) HideForumEntry(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	forum_id, entry_id, ok := f.getModeratedEntry(c)
+	if !ok {
+		return
+	}
+
+	var m _forumModeration
+	if err := c.BindJSON(&m); err != nil {
+		log.Errorf("Unable to bind json: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var err error
+	if m.Hidden {
+		err = forum.HideEntry(f.Database, forum_id, entry_id, user_id, m.Reason)
+	} else {
+		err = forum.UnhideEntry(f.Database, forum_id, entry_id, user_id)
+	}
+	if err != nil {
+		log.Errorf("Unable to hide forum entry: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (f *PublicController) GetForumModerationLog(c *gin.Context) {
+	forum_id, course_role, ok := f.getForumFromCourse(c)
+	if !ok {
+		return
+	}
+	if !AuthorizeCourseModerator(course_role) {
+		log.Infof("User is not authorized")
+		c.Status(http.StatusUnauthorized)
+		return
+	}
+
+	entries, err := forum.GetModerationLog(f.Database, forum_id)
+	if err != nil {
+		log.Errorf("Unable to get moderation log of forum: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, entries)
+}
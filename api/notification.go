@@ -0,0 +1,175 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"learningbay24.de/backend/models"
+	"learningbay24.de/backend/notification"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+type _mailPreference struct {
+	NotificationMail models.UserNotificationMail `json:"notification_mail"`
+}
+
+// interval in which a comment is sent over idle notification streams so proxies don't close them
+const notificationKeepAliveInterval = 30 * time.Second
+
+func (f *PublicController) GetNotifications(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	notifications, err := notification.GetNotifications(f.Database, user_id)
+	if err != nil {
+		log.Errorf("Unable to get notifications of user: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, notifications)
+}
+
+func (f *PublicController) MarkNotificationRead(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	notification_id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	err = notification.MarkRead(f.Database, user_id, notification_id)
+	if err != nil {
+		log.Errorf("Unable to mark notification as read: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (f *PublicController) MarkAllNotificationsRead(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	err := notification.MarkAllRead(f.Database, user_id)
+	if err != nil {
+		log.Errorf("Unable to mark notifications as read: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (f *PublicController) DeleteNotification(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	notification_id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	err = notification.DeleteNotification(f.Database, user_id, notification_id)
+	if err != nil {
+		log.Errorf("Unable to delete notification: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (f *PublicController) SetNotificationMailPreference(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	var preference _mailPreference
+	if err := c.BindJSON(&preference); err != nil {
+		log.Errorf("Unable to bind json: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := notification.SetMailPreference(f.Database, user_id, preference.NotificationMail)
+	if err != nil {
+		log.Errorf("Unable to set mail preference of user: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// StreamNotifications pushes new notifications of the user as Server-Sent Events.
+// Each event carries the ID of its notification, so a reconnecting client sending `Last-Event-ID` receives everything it missed.
+func (f *PublicController) StreamNotifications(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	last_id := 0
+	if h := c.GetHeader("Last-Event-ID"); h != "" {
+		id, err := strconv.Atoi(h)
+		if err != nil {
+			log.Errorf("Unable to convert header `Last-Event-ID` to int: %s", err.Error())
+			c.Status(http.StatusBadRequest)
+			return
+		}
+		last_id = id
+	}
+
+	// subscribe before catching up, so no notification created in between gets lost
+	ch, unsubscribe := notification.DefaultBroker.Subscribe(user_id)
+	defer unsubscribe()
+
+	var missed models.NotificationSlice
+	if last_id > 0 {
+		var err error
+		missed, err = notification.GetNotificationsSince(f.Database, user_id, last_id)
+		if err != nil {
+			log.Errorf("Unable to get missed notifications of user: %s", err.Error())
+			c.IndentedJSON(http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+
+	send := func(n *models.Notification) {
+		c.Render(-1, sse.Event{Id: strconv.Itoa(n.ID), Event: "notification", Data: n})
+		last_id = n.ID
+	}
+	for _, n := range missed {
+		send(n)
+	}
+	c.Writer.Flush()
+
+	keep_alive := time.NewTicker(notificationKeepAliveInterval)
+	defer keep_alive.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case n, ok := <-ch:
+			if !ok {
+				return false
+			}
+			// already sent while catching up
+			if n.ID > last_id {
+				send(n)
+			}
+			return true
+		case <-keep_alive.C:
+			_, err := io.WriteString(w, ": keep-alive\n\n")
+			return err == nil
+		case <-c.Request.Context().Done():
+			return false
+		}
+	})
+}
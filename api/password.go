@@ -0,0 +1,65 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"learningbay24.de/backend/recovery"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+// ForgotPassword mails a link to reset the password to the given email address.
+// The response is the same whether a user with the address exists or not.
+func (f *PublicController) ForgotPassword(c *gin.Context) {
+	var request struct {
+		Email string `json:"email"`
+	}
+	if err := c.BindJSON(&request); err != nil {
+		log.Errorf("Unable to bind json: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if recovery.MailSender == nil {
+		log.Errorf("Unable to reset password: sending mails is disabled")
+		c.Status(http.StatusServiceUnavailable)
+		return
+	}
+
+	// the mail is sent in the background, so the response time doesn't tell whether the address exists
+	go func(email string) {
+		if err := recovery.RequestReset(f.Database, email); err != nil {
+			log.Errorf("Unable to request password reset: %s", err.Error())
+		}
+	}(request.Email)
+
+	c.Status(http.StatusAccepted)
+}
+
+// ResetPassword sets a new password for the user a reset token was mailed to and logs the user out everywhere
+func (f *PublicController) ResetPassword(c *gin.Context) {
+	var request struct {
+		Token    string `json:"token"`
+		Password string `json:"password"`
+	}
+	if err := c.BindJSON(&request); err != nil {
+		log.Errorf("Unable to bind json: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := recovery.Reset(f.Database, request.Token, []byte(request.Password))
+	if errors.Is(err, recovery.ErrInvalidToken) || errors.Is(err, recovery.ErrPasswordTooShort) {
+		log.Infof("Unable to reset password: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	} else if err != nil {
+		log.Errorf("Unable to reset password: %s", err.Error())
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"learningbay24.de/backend/course"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+type _prerequisite struct {
+	RequiredCourseID int `json:"required_course_id"`
+}
+
+func (f *PublicController) GetPrerequisitesOfCourse(c *gin.Context) {
+	course_id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	required, err := course.GetPrerequisites(f.Database, course_id)
+	if err != nil {
+		log.Errorf("Unable to get prerequisites of course: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, required)
+}
+
+func (f *PublicController) AddPrerequisiteToCourse(c *gin.Context) {
+	course_id, ok := f.getAdministeredCourse(c)
+	if !ok {
+		return
+	}
+
+	var prerequisite _prerequisite
+	if err := c.BindJSON(&prerequisite); err != nil {
+		log.Errorf("Unable to bind json: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := course.AddPrerequisite(f.Database, course_id, prerequisite.RequiredCourseID)
+	if err != nil {
+		log.Errorf("Unable to add prerequisite to course: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (f *PublicController) RemovePrerequisiteFromCourse(c *gin.Context) {
+	course_id, ok := f.getAdministeredCourse(c)
+	if !ok {
+		return
+	}
+
+	required_course_id, err := strconv.Atoi(c.Param("required_course_id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `required_course_id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	err = course.RemovePrerequisite(f.Database, course_id, required_course_id)
+	if err != nil {
+		log.Errorf("Unable to remove prerequisite from course: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// getAdministeredCourse reads the course from the parameter `id` and checks whether the user is an admin of it.
+// On failure the response status is set and ok is false.
+func (f *PublicController) getAdministeredCourse(c *gin.Context) (courseId int, ok bool) {
+	course_id, course_role, ok := f.getCourseMembership(c)
+	if !ok {
+		return 0, false
+	}
+	if !AuthorizeCourseAdmin(course_role) {
+		log.Infof("User is not authorized")
+		c.Status(http.StatusUnauthorized)
+		return 0, false
+	}
+
+	return course_id, true
+}
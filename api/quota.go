@@ -0,0 +1,123 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"learningbay24.de/backend/dbi"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+)
+
+// quota in bytes, null removes the override
+type _quota struct {
+	Quota null.Int64 `json:"quota"`
+}
+
+func (f *PublicController) GetQuotaFromUser(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	quota, err := dbi.GetQuota(f.Database, user_id)
+	if err != nil {
+		log.Errorf("Unable to get quota of user: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, quota)
+}
+
+func (f *PublicController) SetUserQuota(c *gin.Context) {
+	role_id := c.MustGet("CookieRoleId").(int)
+	if !AuthorizeAdmin(role_id) {
+		log.Infof("User is not authorized")
+		c.Status(http.StatusUnauthorized)
+		return
+	}
+
+	user_id, err := strconv.Atoi(c.Param("user_id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `user_id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	var quota _quota
+	if err := c.BindJSON(&quota); err != nil {
+		log.Errorf("Unable to bind json: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err = dbi.SetUserQuota(f.Database, user_id, quota.Quota)
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Errorf("User with id %d doesn't exist: %s", user_id, err.Error())
+		c.Status(http.StatusNotFound)
+		return
+	} else if err != nil {
+		log.Errorf("Unable to set quota of user: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (f *PublicController) SetRoleQuota(c *gin.Context) {
+	role_id := c.MustGet("CookieRoleId").(int)
+	if !AuthorizeAdmin(role_id) {
+		log.Infof("User is not authorized")
+		c.Status(http.StatusUnauthorized)
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	var quota _quota
+	if err := c.BindJSON(&quota); err != nil {
+		log.Errorf("Unable to bind json: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err = dbi.SetRoleQuota(f.Database, id, quota.Quota)
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Errorf("Role with id %d doesn't exist: %s", id, err.Error())
+		c.Status(http.StatusNotFound)
+		return
+	} else if err != nil {
+		log.Errorf("Unable to set quota of role: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RepairQuotas recomputes the bytes uploaded by every user from the stored files and returns how many users were corrected
+func (f *PublicController) RepairQuotas(c *gin.Context) {
+	role_id := c.MustGet("CookieRoleId").(int)
+	if !AuthorizeAdmin(role_id) {
+		log.Infof("User is not authorized")
+		c.Status(http.StatusUnauthorized)
+		return
+	}
+
+	repaired, err := dbi.RepairUploadedBytes(f.Database)
+	if err != nil {
+		log.Errorf("Unable to repair quotas: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, repaired)
+}
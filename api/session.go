@@ -0,0 +1,190 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"learningbay24.de/backend/config"
+	"learningbay24.de/backend/dbi"
+	"learningbay24.de/backend/models"
+	"learningbay24.de/backend/session"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+// names of the cookies holding the access and refresh token
+const (
+	accessTokenCookie  = "user_token"
+	refreshTokenCookie = "refresh_token"
+)
+
+// Tokens are the tokens issued for a session. Browsers get them as cookies, scripts and apps read them from the response instead.
+type Tokens struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	// seconds until the access token expires
+	ExpiresIn int `json:"expires_in"`
+}
+
+// issueTokens signs an access token for a session of a user and sets it as cookie, along with the session's current refresh token, and returns both
+func issueTokens(c *gin.Context, user *models.User, s *models.Session, refreshToken string) (*Tokens, error) {
+	tokenString, err := signAccessToken(Identity{user.ID, user.RoleID, s.ID}, session.AccessTokenLifetime())
+	if err != nil {
+		return nil, err
+	}
+
+	c.SetCookie(accessTokenCookie, tokenString, int(session.AccessTokenLifetime().Seconds()), "/", config.Conf.Domain, config.Conf.Secure, true)
+	c.SetCookie(refreshTokenCookie, refreshToken, int(time.Until(s.ExpiresAt).Seconds()), "/", config.Conf.Domain, config.Conf.Secure, true)
+	return &Tokens{tokenString, refreshToken, int(session.AccessTokenLifetime().Seconds())}, nil
+}
+
+// refreshTokenFromRequest returns the refresh token of a request, sent either as cookie by browsers or as `refresh_token` in a JSON body by scripts and apps
+func refreshTokenFromRequest(c *gin.Context) (string, bool) {
+	if token, err := c.Cookie(refreshTokenCookie); err == nil && token != "" {
+		return token, true
+	}
+
+	var body struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil || body.RefreshToken == "" {
+		return "", false
+	}
+	return body.RefreshToken, true
+}
+
+// clearTokens removes the cookies holding the access and refresh token
+func clearTokens(c *gin.Context) {
+	c.SetCookie(accessTokenCookie, "", -1, "/", config.Conf.Domain, config.Conf.Secure, true)
+	c.SetCookie(refreshTokenCookie, "", -1, "/", config.Conf.Domain, config.Conf.Secure, true)
+}
+
+// Refresh replaces the refresh token of the client's session and issues a new access token for it
+func (f *PublicController) Refresh(c *gin.Context) {
+	refresh_token, ok := refreshTokenFromRequest(c)
+	if !ok {
+		log.Infof("No refresh token found in request")
+		c.Status(http.StatusUnauthorized)
+		return
+	}
+
+	s, refresh_token, err := session.Refresh(f.Database, refresh_token, c.Request.UserAgent(), c.ClientIP())
+	if errors.Is(err, session.ErrRefreshedConcurrently) {
+		// another request of the client got the new tokens already, which replace the ones sent with this request
+		log.Infof("Rejected refresh token: %s", err.Error())
+		c.Status(http.StatusConflict)
+		return
+	} else if errors.Is(err, session.ErrInvalid) {
+		log.Infof("Rejected refresh token: %s", err.Error())
+		clearTokens(c)
+		c.Status(http.StatusUnauthorized)
+		return
+	} else if err != nil {
+		log.Errorf("Unable to refresh session: %s", err.Error())
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	user, err := dbi.GetUserById(f.Database, s.UserID)
+	if err != nil {
+		log.Errorf("Unable to get user by id: %s", err.Error())
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	tokens, err := issueTokens(c, user, s, refresh_token)
+	if err != nil {
+		log.Errorf("Unable to sign token: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, tokens)
+}
+
+// GetSessionsFromUser lists the active sessions of the user, marking the one the request was sent from
+func (f *PublicController) GetSessionsFromUser(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+	session_id := c.MustGet("CookieSessionId").(int)
+
+	sessions, err := session.List(f.Database, user_id)
+	if err != nil {
+		log.Errorf("Unable to get sessions of user: %s", err.Error())
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	type _session struct {
+		ID         int       `json:"id"`
+		Device     string    `json:"device"`
+		IP         string    `json:"ip"`
+		CreatedAt  time.Time `json:"created_at"`
+		LastSeenAt time.Time `json:"last_seen_at"`
+		Current    bool      `json:"current"`
+	}
+
+	_sessions := make([]_session, 0, len(sessions))
+	for _, s := range sessions {
+		_sessions = append(_sessions, _session{s.ID, s.Device, s.IP, s.CreatedAt, s.LastSeenAt, s.ID == session_id})
+	}
+
+	c.IndentedJSON(http.StatusOK, _sessions)
+}
+
+// RevokeSession ends one of the user's sessions, logging out the device using it
+func (f *PublicController) RevokeSession(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	err = session.Revoke(f.Database, id, user_id)
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Infof("Session %d of user %d not found", id, user_id)
+		c.Status(http.StatusNotFound)
+		return
+	} else if err != nil {
+		log.Errorf("Unable to revoke session: %s", err.Error())
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	if id == c.MustGet("CookieSessionId").(int) {
+		clearTokens(c)
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// RevokeUserSessions ends all sessions of a user and returns how many were active
+func (f *PublicController) RevokeUserSessions(c *gin.Context) {
+	role_id := c.MustGet("CookieRoleId").(int)
+	if !AuthorizeAdmin(role_id) {
+		log.Infof("User is not authorized")
+		c.Status(http.StatusUnauthorized)
+		return
+	}
+
+	user_id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	revoked, err := session.RevokeAll(f.Database, user_id)
+	if err != nil {
+		log.Errorf("Unable to revoke sessions of user: %s", err.Error())
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, revoked)
+}
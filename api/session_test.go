@@ -0,0 +1,41 @@
+package api
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefreshTokenFromRequest(t *testing.T) {
+	tests := []struct {
+		name   string
+		cookie string
+		body   string
+		token  string
+	}{
+		{"cookie", "refresh_token=xyz", "", "xyz"},
+		{"body", "", `{"refresh_token": "xyz"}`, "xyz"},
+		{"cookie preferred over body", "refresh_token=xyz", `{"refresh_token": "other"}`, "xyz"},
+		{"nothing", "", "", ""},
+		{"empty body", "", `{}`, ""},
+		{"invalid body", "", `refresh_token=xyz`, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest("POST", "/refresh", strings.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			if tt.cookie != "" {
+				c.Request.Header.Set("Cookie", tt.cookie)
+			}
+
+			token, ok := refreshTokenFromRequest(c)
+			assert.Equal(t, tt.token != "", ok)
+			assert.Equal(t, tt.token, token)
+		})
+	}
+}
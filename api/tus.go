@@ -0,0 +1,410 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"learningbay24.de/backend/config"
+	"learningbay24.de/backend/course"
+	coursematerial "learningbay24.de/backend/courseMaterial"
+	"learningbay24.de/backend/dbi"
+	"learningbay24.de/backend/exam"
+	"learningbay24.de/backend/models"
+	"learningbay24.de/backend/upload"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+// version of the tus protocol for resumable uploads, see https://tus.io/protocols/resumable-upload.html
+const tusVersion = "1.0.0"
+
+// SetTusHeaders adds the headers tus clients use to discover the supported protocol to the response of an OPTIONS request
+func SetTusHeaders(c *gin.Context) {
+	c.Header("Tus-Resumable", tusVersion)
+	c.Header("Tus-Version", tusVersion)
+	c.Header("Tus-Extension", "creation,termination")
+}
+
+// checkTusVersion responds with 412 if the client speaks another version of the tus protocol and reports whether it speaks the supported one
+func checkTusVersion(c *gin.Context) bool {
+	if c.GetHeader("Tus-Resumable") != tusVersion {
+		log.Infof("Unsupported tus version: %s", c.GetHeader("Tus-Resumable"))
+		c.Header("Tus-Version", tusVersion)
+		c.Status(http.StatusPreconditionFailed)
+		return false
+	}
+
+	c.Header("Tus-Resumable", tusVersion)
+	return true
+}
+
+// parseUploadMetadata parses the `Upload-Metadata` header, a comma separated list of keys followed by their base64 encoded values
+func parseUploadMetadata(header string) (map[string]string, error) {
+	meta := make(map[string]string)
+	for _, pair := range strings.Split(header, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		key, value, _ := strings.Cut(pair, " ")
+		decoded, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of metadata \"%s\": %w", key, err)
+		}
+		meta[key] = string(decoded)
+	}
+
+	return meta, nil
+}
+
+// authorizeUpload checks whether a user may attach a file to the target of an upload and whether the file fits its limits, the user's quota and the allowed file types.
+// Otherwise it responds with the matching status and returns false.
+func (f *PublicController) authorizeUpload(c *gin.Context, user_id int, target string, target_id int, file_name string, length int64) bool {
+	pCtrl := exam.PublicController{Database: f.Database}
+	allowed := [][]string{config.Conf.Files.AllowedFileTypes}
+	authorized := false
+
+	switch target {
+	case upload.TargetMaterial:
+		course_role, err := course.GetCourseRole(f.Database, user_id, target_id)
+		if err != nil {
+			log.Errorf("Unable to get course role: %s", err.Error())
+			c.Status(http.StatusInternalServerError)
+			return false
+		}
+		authorized = AuthorizeCourseModerator(course_role)
+	case upload.TargetExam:
+		co, err := pCtrl.GetCourseFromExam(target_id)
+		if err != nil {
+			log.Errorf("Unable to get course from exam: %s", err.Error())
+			c.IndentedJSON(http.StatusBadRequest, err.Error())
+			return false
+		}
+
+		course_role, err := course.GetCourseRole(f.Database, user_id, co.ID)
+		if err != nil {
+			log.Errorf("Unable to get course role: %s", err.Error())
+			c.Status(http.StatusInternalServerError)
+			return false
+		}
+		authorized = AuthorizeCourseModerator(course_role)
+	case upload.TargetAnswer:
+		var err error
+		authorized, err = f.AuthorizeUserHasExam(user_id, target_id)
+		if err != nil {
+			log.Errorf("Unable to check whether user has exam: %s", err.Error())
+			c.Status(http.StatusInternalServerError)
+			return false
+		}
+	case upload.TargetSubmission:
+		course_id, err := course.GetCourseIdByUserSubmission(f.Database, target_id)
+		if err != nil {
+			log.Errorf("Unable to get `course_id` by submission: %s", err.Error())
+			c.Status(http.StatusBadRequest)
+			return false
+		}
+
+		course_role, err := course.GetCourseRole(f.Database, user_id, course_id)
+		if err != nil {
+			log.Errorf("Unable to get course role: %s", err.Error())
+			c.Status(http.StatusInternalServerError)
+			return false
+		}
+		authorized = AuthorizeCourseUser(course_role)
+
+		limits, err := course.GetUploadLimitsOfUserSubmission(f.Database, target_id)
+		if err != nil {
+			log.Errorf("Unable to get upload limits of user submission: %s", err.Error())
+			c.Status(http.StatusInternalServerError)
+			return false
+		}
+		if authorized && limits.MaxFilesize != 0 && length > limits.MaxFilesize {
+			rejectUpload(c, dbi.FileTooLarge(limits.MaxFilesize))
+			return false
+		}
+		allowed = append(allowed, limits.AllowedFileTypes)
+	default:
+		log.Errorf("Invalid upload target: %s", target)
+		c.IndentedJSON(http.StatusBadRequest, fmt.Sprintf("invalid target \"%s\"", target))
+		return false
+	}
+
+	if !authorized {
+		log.Infof("User is not authorized")
+		c.Status(http.StatusUnauthorized)
+		return false
+	}
+
+	for _, a := range allowed {
+		if err := dbi.CheckFileExtension(file_name, a); err != nil {
+			rejectUpload(c, err)
+			return false
+		}
+	}
+
+	err := dbi.CheckQuota(f.Database, user_id, length)
+	if err != nil {
+		if rejectUpload(c, err) {
+			return false
+		}
+		log.Errorf("Unable to check quota of user: %s", err.Error())
+		c.Status(http.StatusInternalServerError)
+		return false
+	}
+
+	return true
+}
+
+// attachUpload saves the content of a complete upload as a file attached to the upload's target.
+// The file is saved by the last step, so when attaching fails, retrying it doesn't save the file twice.
+func (f *PublicController) attachUpload(u *models.Upload, content io.Reader) error {
+	pCtrl := exam.PublicController{Database: f.Database}
+	size := int(u.Length)
+
+	switch u.Target {
+	case upload.TargetMaterial:
+		return coursematerial.CreateMaterial(f.Database, u.FileName, "", u.UserID, u.TargetID, true, content, size)
+	case upload.TargetExam:
+		return pCtrl.UploadExamFile(u.FileName, "", u.UserID, u.TargetID, true, content, size)
+	case upload.TargetAnswer:
+		// attending can be repeated, so it comes first: if saving the answer fails, retrying doesn't save it twice
+		if err := pCtrl.AttendExam(u.TargetID, u.UserID); err != nil {
+			return err
+		}
+		return pCtrl.SubmitAnswer(u.FileName, "", u.TargetID, u.UserID, true, content, size)
+	case upload.TargetSubmission:
+		return course.CreateUserSubmissionHasFiles(f.Database, u.TargetID, u.FileName, "", u.UserID, true, content, size)
+	}
+
+	return fmt.Errorf("invalid target \"%s\"", u.Target)
+}
+
+// CreateUpload starts a resumable upload. The `Upload-Metadata` header has to contain the `filename`, the `target` the file is attached to once it is complete and the `target_id`.
+func (f *PublicController) CreateUpload(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	if !checkTusVersion(c) {
+		return
+	}
+
+	length, err := strconv.ParseInt(c.GetHeader("Upload-Length"), 10, 64)
+	if err != nil || length < 0 {
+		log.Errorf("Invalid header `Upload-Length`: %s", c.GetHeader("Upload-Length"))
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	meta, err := parseUploadMetadata(c.GetHeader("Upload-Metadata"))
+	if err != nil {
+		log.Errorf("Unable to parse header `Upload-Metadata`: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	target_id, err := strconv.Atoi(meta["target_id"])
+	if err != nil {
+		log.Errorf("Unable to convert metadata `target_id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	if !f.authorizeUpload(c, user_id, meta["target"], target_id, meta["filename"], length) {
+		return
+	}
+
+	u, err := upload.Create(f.Database, user_id, meta["target"], target_id, meta["filename"], length)
+	if err != nil {
+		log.Errorf("Unable to create upload: %s", err.Error())
+		c.IndentedJSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// relative to the URL of this request, so it stays valid behind a proxy serving the API below a path
+	c.Header("Location", "uploads/"+u.ID)
+	c.Status(http.StatusCreated)
+}
+
+// GetUpload tells a client resuming an upload how many bytes were received
+func (f *PublicController) GetUpload(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	if !checkTusVersion(c) {
+		return
+	}
+
+	u, ok := f.getUpload(c, user_id)
+	if !ok {
+		return
+	}
+
+	c.Header("Upload-Offset", strconv.FormatInt(u.UploadOffset, 10))
+	c.Header("Upload-Length", strconv.FormatInt(u.Length, 10))
+	c.Header("Cache-Control", "no-store")
+	c.Status(http.StatusOK)
+}
+
+// PatchUpload appends the bytes in the request body to an upload. Once all bytes are received, the file is attached to the upload's target.
+func (f *PublicController) PatchUpload(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	if !checkTusVersion(c) {
+		return
+	}
+	if c.ContentType() != "application/offset+octet-stream" {
+		log.Errorf("Invalid content type for upload: %s", c.ContentType())
+		c.Status(http.StatusUnsupportedMediaType)
+		return
+	}
+
+	offset, err := strconv.ParseInt(c.GetHeader("Upload-Offset"), 10, 64)
+	if err != nil {
+		log.Errorf("Unable to convert header `Upload-Offset` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	unlock, ok := f.lockUpload(c)
+	if !ok {
+		return
+	}
+	defer unlock()
+
+	u, ok := f.getUpload(c, user_id)
+	if !ok {
+		return
+	}
+	if u.FinishedAt.Valid {
+		c.Header("Upload-Offset", strconv.FormatInt(u.UploadOffset, 10))
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	err = upload.Append(f.Database, u, offset, c.Request.Body)
+	if errors.Is(err, upload.ErrOffsetMismatch) {
+		log.Infof("Rejected upload: %s", err.Error())
+		c.IndentedJSON(http.StatusConflict, err.Error())
+		return
+	} else if err != nil {
+		// the client resumes from the bytes received so far
+		log.Errorf("Unable to receive upload: %s", err.Error())
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	if upload.Complete(u) && !f.finishUpload(c, u) {
+		return
+	}
+
+	c.Header("Upload-Offset", strconv.FormatInt(u.UploadOffset, 10))
+	c.Status(http.StatusNoContent)
+}
+
+// finishUpload attaches a complete upload to its target. If the file is rejected, the upload is removed and the matching status is sent.
+func (f *PublicController) finishUpload(c *gin.Context, u *models.Upload) bool {
+	// permissions and quota could have changed while the upload was running
+	if !f.authorizeUpload(c, u.UserID, u.Target, u.TargetID, u.FileName, u.Length) {
+		if err := upload.Remove(f.Database, u); err != nil {
+			log.Errorf("Unable to remove upload: %s", err.Error())
+		}
+		return false
+	}
+
+	content, err := upload.Open(f.Database, u)
+	if err != nil {
+		log.Errorf("Unable to open upload: %s", err.Error())
+		c.Status(http.StatusInternalServerError)
+		return false
+	}
+	err = f.attachUpload(u, content)
+	content.Close()
+	if err != nil {
+		if rejectUpload(c, err) {
+			if err := upload.Remove(f.Database, u); err != nil {
+				log.Errorf("Unable to remove upload: %s", err.Error())
+			}
+			return false
+		}
+		// keep the upload, so the client can retry finishing it by sending an empty body
+		log.Errorf("Unable to attach upload to %s: %s", u.Target, err.Error())
+		c.Status(http.StatusInternalServerError)
+		return false
+	}
+
+	if err := upload.Finish(f.Database, u); err != nil {
+		log.Errorf("Unable to finish upload: %s", err.Error())
+	}
+	return true
+}
+
+// DeleteUpload cancels an upload and removes the bytes received
+func (f *PublicController) DeleteUpload(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	if !checkTusVersion(c) {
+		return
+	}
+
+	unlock, ok := f.lockUpload(c)
+	if !ok {
+		return
+	}
+	defer unlock()
+
+	u, ok := f.getUpload(c, user_id)
+	if !ok {
+		return
+	}
+
+	err := upload.Remove(f.Database, u)
+	if err != nil {
+		log.Errorf("Unable to remove upload: %s", err.Error())
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// lockUpload reserves the upload named by the parameter `id` for the request, otherwise it responds with 404 if it doesn't exist, 423 if another request holds it or 500
+func (f *PublicController) lockUpload(c *gin.Context) (func(), bool) {
+	unlock, err := upload.Lock(f.Database, c.Param("id"))
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Infof("Upload %s not found", c.Param("id"))
+		c.Status(http.StatusNotFound)
+		return nil, false
+	} else if errors.Is(err, upload.ErrLocked) {
+		log.Infof("Rejected upload: %s", err.Error())
+		c.IndentedJSON(http.StatusLocked, err.Error())
+		return nil, false
+	} else if err != nil {
+		log.Errorf("Unable to lock upload: %s", err.Error())
+		c.Status(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	return unlock, true
+}
+
+// getUpload returns the upload named by the parameter `id` if it belongs to the user, otherwise it responds with 404
+func (f *PublicController) getUpload(c *gin.Context, user_id int) (*models.Upload, bool) {
+	u, err := upload.Get(f.Database, c.Param("id"), user_id)
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Infof("Upload %s not found", c.Param("id"))
+		c.Status(http.StatusNotFound)
+		return nil, false
+	} else if err != nil {
+		log.Errorf("Unable to get upload: %s", err.Error())
+		c.Status(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	return u, true
+}
@@ -0,0 +1,240 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"learningbay24.de/backend/storage"
+	"learningbay24.de/backend/upload"
+)
+
+const uploadId = "0b7e5a4c-1d2f-4e3a-9b8c-7d6e5f4a3b2c"
+
+// patchUpload sends bytes continuing at offset to the upload of user 1 and returns the response
+func patchUpload(f *PublicController, offset int64, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest("PATCH", "/uploads/"+uploadId, strings.NewReader(body))
+	c.Request.Header.Set("Tus-Resumable", tusVersion)
+	c.Request.Header.Set("Content-Type", "application/offset+octet-stream")
+	c.Request.Header.Set("Upload-Offset", strconv.FormatInt(offset, 10))
+	c.Params = gin.Params{{Key: "id", Value: uploadId}}
+	c.Set("CookieUserId", 1)
+
+	f.PatchUpload(c)
+	// statuses without a body are only written once the handler returns
+	c.Writer.WriteHeaderNow()
+	return w
+}
+
+// uploadRows returns an upload of an answer to exam 3 by user 1
+func uploadRows(length, offset int64) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "user_id", "target", "target_id", "file_name", "length", "upload_offset", "created_at", "finished_at", "locked_at"}).
+		AddRow(uploadId, 1, upload.TargetAnswer, 3, "answer.txt", length, offset, time.Now(), nil, time.Now())
+}
+
+func expectLock(mock sqlmock.Sqlmock) {
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE upload SET locked_at = ?")).WithArgs(sqlmock.AnyArg(), uploadId, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func expectUnlock(mock sqlmock.Sqlmock) {
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE upload SET locked_at = NULL")).WithArgs(uploadId).WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+// expectAppend expects the bytes starting at offset to be recorded as a part
+func expectAppend(mock sqlmock.Sqlmock, offset, size int64) {
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `upload_part`")).WithArgs(uploadId, offset, sqlmock.AnyArg(), size).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `upload` SET `upload_offset`=?")).WithArgs(offset+size, uploadId).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+}
+
+func partRows(size int64) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"upload_id", "offset", "uri", "size"}).AddRow(uploadId, 0, "local:uploads/"+uploadId+"/0", size)
+}
+
+func TestPatchUploadOffsetMismatch(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+
+	expectLock(mock)
+	mock.ExpectQuery(regexp.QuoteMeta("select * from `upload` where `id`=?")).WithArgs(uploadId).WillReturnRows(uploadRows(13, 7))
+	expectUnlock(mock)
+
+	w := patchUpload(&PublicController{Database: db}, 0, "lecture")
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPatchUploadLocked(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+
+	// another request is appending to the upload
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE upload SET locked_at = ?")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("from `upload`")).WithArgs(uploadId).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(1))
+
+	w := patchUpload(&PublicController{Database: db}, 0, "lecture")
+	assert.Equal(t, http.StatusLocked, w.Code)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPatchUploadPartial(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+	storage.Register("local", storage.NewLocal(t.TempDir()))
+
+	expectLock(mock)
+	mock.ExpectQuery(regexp.QuoteMeta("select * from `upload` where `id`=?")).WithArgs(uploadId).WillReturnRows(uploadRows(13, 0))
+	expectAppend(mock, 0, 7)
+	expectUnlock(mock)
+
+	w := patchUpload(&PublicController{Database: db}, 0, "lecture")
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, "7", w.Header().Get("Upload-Offset"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPatchUploadUnauthorized(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+	dir := t.TempDir()
+	storage.Register("local", storage.NewLocal(dir))
+
+	expectLock(mock)
+	mock.ExpectQuery(regexp.QuoteMeta("select * from `upload` where `id`=?")).WithArgs(uploadId).WillReturnRows(uploadRows(7, 0))
+	expectAppend(mock, 0, 7)
+	// the user was deregistered from the exam while uploading
+	mock.ExpectQuery(regexp.QuoteMeta("from `user_has_exam`")).WithArgs(1, 3).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(0))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `upload_part`")).WithArgs(uploadId).WillReturnRows(partRows(7))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `upload_part`")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `upload` WHERE `id`=?")).WithArgs(uploadId).WillReturnResult(sqlmock.NewResult(0, 1))
+	expectUnlock(mock)
+
+	w := patchUpload(&PublicController{Database: db}, 0, "lecture")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	// the received bytes are removed along with the upload
+	assert.NoFileExists(t, filepath.Join(dir, "uploads", uploadId, "0"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// expectAuthorizedAnswer expects the checks whether user 1 may upload an answer to exam 3
+func expectAuthorizedAnswer(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(regexp.QuoteMeta("from `user_has_exam`")).WithArgs(1, 3).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta("select * from `user` where `id`=?")).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "role_id", "uploaded_bytes", "upload_quota"}).AddRow(1, 3, 0, nil))
+	mock.ExpectQuery(regexp.QuoteMeta("select * from `role` where `id`=?")).WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "upload_quota"}).AddRow(3, nil))
+}
+
+// expectRunningExam expects exam 3 to be found running, with user 1 registered to it
+func expectRunningExam(mock sqlmock.Sqlmock) {
+	// exams are compared to the current time shifted by two hours
+	mock.ExpectQuery(regexp.QuoteMeta("select * from `exam` where `id`=?")).WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "date", "duration"}).AddRow(3, time.Now().Add(2*time.Hour-time.Minute), 60))
+	mock.ExpectQuery(regexp.QuoteMeta("select * from `user_has_exam` where `user_id`=? AND `exam_id`=?")).WithArgs(1, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "exam_id", "attended"}).AddRow(1, 3, 0))
+}
+
+func TestPatchUploadFinish(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+	dir := t.TempDir()
+	storage.Register("local", storage.NewLocal(dir))
+
+	expectLock(mock)
+	mock.ExpectQuery(regexp.QuoteMeta("select * from `upload` where `id`=?")).WithArgs(uploadId).WillReturnRows(uploadRows(7, 0))
+	expectAppend(mock, 0, 7)
+	expectAuthorizedAnswer(mock)
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `upload_part`")).WithArgs(uploadId).WillReturnRows(partRows(7))
+
+	// the user attends the exam and the answer is saved
+	expectRunningExam(mock)
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `user_has_exam` SET")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `user` WHERE (`user`.`id` = ?)")).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "role_id", "uploaded_bytes", "upload_quota"}).AddRow(1, 3, 0, nil))
+	mock.ExpectQuery(regexp.QuoteMeta("select * from `role` where `id`=?")).WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "upload_quota"}).AddRow(3, nil))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `user` SET `uploaded_bytes`=?")).WithArgs(int64(7), 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO file_blob")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("select * from `file_blob` where `hash`=?")).
+		WillReturnRows(sqlmock.NewRows([]string{"hash", "uri", "size", "ref_count"}).AddRow("hash", "local:blobs/ha/hash", 7, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `file`")).WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta("select * from `user_has_exam` where `user_id`=? AND `exam_id`=?")).WithArgs(1, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "exam_id", "attended"}).AddRow(1, 3, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `user_has_exam` SET")).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// the upload is finished and its parts are removed
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `upload` SET `finished_at`=?")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `upload_part`")).WithArgs(uploadId).WillReturnRows(partRows(7))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `upload_part`")).WillReturnResult(sqlmock.NewResult(0, 1))
+	expectUnlock(mock)
+
+	w := patchUpload(&PublicController{Database: db}, 0, "lecture")
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, "7", w.Header().Get("Upload-Offset"))
+
+	assert.NoFileExists(t, filepath.Join(dir, "uploads", uploadId, "0"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPatchUploadFinishRetry(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+	dir := t.TempDir()
+	storage.Register("local", storage.NewLocal(dir))
+
+	part := filepath.Join(dir, "uploads", uploadId, "0")
+	if err := os.MkdirAll(filepath.Dir(part), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	os.WriteFile(part, []byte("lecture"), 0o644)
+
+	// all bytes were received, but attaching the file failed before
+	expectLock(mock)
+	mock.ExpectQuery(regexp.QuoteMeta("select * from `upload` where `id`=?")).WithArgs(uploadId).WillReturnRows(uploadRows(7, 7))
+	expectAuthorizedAnswer(mock)
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `upload_part`")).WithArgs(uploadId).WillReturnRows(partRows(7))
+	// attending fails again, before the answer is saved
+	mock.ExpectQuery(regexp.QuoteMeta("select * from `exam` where `id`=?")).WithArgs(3).WillReturnError(errors.New("connection lost"))
+	expectUnlock(mock)
+
+	w := patchUpload(&PublicController{Database: db}, 7, "")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+	// the upload is kept for the next retry
+	assert.FileExists(t, part)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,128 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"learningbay24.de/backend/course"
+	"learningbay24.de/backend/dbi"
+	"learningbay24.de/backend/scanner"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+// rejectUpload responds with the matching status if err was caused by a file that isn't allowed to be uploaded and reports whether it did so
+func rejectUpload(c *gin.Context, err error) bool {
+	if errors.Is(err, dbi.ErrFileTypeNotAllowed) {
+		log.Infof("Rejected upload: %s", err.Error())
+		c.IndentedJSON(http.StatusUnsupportedMediaType, err.Error())
+		return true
+	}
+	if errors.Is(err, dbi.ErrFileTooLarge) || errors.Is(err, dbi.ErrQuotaExceeded) {
+		log.Infof("Rejected upload: %s", err.Error())
+		c.IndentedJSON(http.StatusRequestEntityTooLarge, err.Error())
+		return true
+	}
+	if errors.Is(err, scanner.ErrInfected) {
+		log.Infof("Rejected upload: %s", err.Error())
+		c.IndentedJSON(http.StatusUnprocessableEntity, err.Error())
+		return true
+	}
+
+	return false
+}
+
+// room for the headers and boundaries of a multipart body on top of the size of the file itself
+const multipartOverhead = 64 << 10
+
+// countingBody counts the bytes read from a request body
+type countingBody struct {
+	io.ReadCloser
+	n int64
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n += int64(n)
+	return n, err
+}
+
+// limitRequestBody makes reading the request body fail once it gets larger than a file of maxBytes would need, so oversized uploads never reach the disk.
+// If the request already announces a larger body, it responds with 413 and ok is false.
+// After reading the body failed, tooLarge reports whether the limit was the reason.
+func limitRequestBody(c *gin.Context, maxBytes int64) (tooLarge func() bool, ok bool) {
+	limit := maxBytes + multipartOverhead
+	if c.Request.ContentLength > limit {
+		rejectUpload(c, dbi.FileTooLarge(maxBytes))
+		return nil, false
+	}
+
+	body := &countingBody{ReadCloser: http.MaxBytesReader(c.Writer, c.Request.Body, limit)}
+	c.Request.Body = body
+	return func() bool { return body.n >= limit }, true
+}
+
+// getFileTypes reads the optional list of file types `allowed_file_types` from a json body
+func getFileTypes(j map[string]interface{}) ([]string, bool) {
+	raw, ok := j["allowed_file_types"]
+	if !ok || raw == nil {
+		return nil, true
+	}
+
+	list, ok := raw.([]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	types := make([]string, 0, len(list))
+	for _, t := range list {
+		s, ok := t.(string)
+		if !ok {
+			return nil, false
+		}
+		types = append(types, s)
+	}
+	return types, true
+}
+
+func (f *PublicController) GetSubmissionUploadLimits(c *gin.Context) {
+	user_id := c.MustGet("CookieUserId").(int)
+
+	submission_id, err := strconv.Atoi(c.Param("submission_id"))
+	if err != nil {
+		log.Errorf("Unable to convert parameter `submission_id` to int: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	course_id, err := course.GetCourseIdBySubmission(f.Database, submission_id)
+	if err != nil {
+		log.Errorf("Unable to get `course_id` by submission: %s", err.Error())
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	course_role, err := course.GetCourseRole(f.Database, user_id, course_id)
+	if err != nil {
+		log.Errorf("Unable to get course role: %s", err.Error())
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+	if !AuthorizeCourseUser(course_role) {
+		log.Infof("User is not authorized")
+		c.Status(http.StatusUnauthorized)
+		return
+	}
+
+	limits, err := course.GetUploadLimits(f.Database, submission_id)
+	if err != nil {
+		log.Errorf("Unable to get upload limits of submission: %s", err.Error())
+		c.IndentedJSON(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, limits)
+}
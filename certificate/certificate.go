@@ -0,0 +1,71 @@
+package certificate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"learningbay24.de/backend/models"
+)
+
+// GetCertificatesFromUser takes the ID of a user and returns all certificates issued to them, newest first
+func GetCertificatesFromUser(db *sql.DB, userId int) (models.CertificateSlice, error) {
+	certificates, err := models.Certificates(
+		models.CertificateWhere.UserID.EQ(userId),
+		qm.OrderBy(models.CertificateColumns.CreatedAt+" DESC"),
+	).All(context.Background(), db)
+	if err != nil {
+		return nil, err
+	}
+	return certificates, nil
+}
+
+// GetCertificate takes the ID of a certificate and returns it
+func GetCertificate(db *sql.DB, id string) (*models.Certificate, error) {
+	c, err := models.FindCertificate(context.Background(), db, id)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// IssueForExam takes a transaction, the ID of a user and an exam and issues a certificate for the exam's course to the user.
+// If the user already holds a certificate for the exam, the existing one is returned instead.
+func IssueForExam(tx *sql.Tx, userId, examId int) (*models.Certificate, error) {
+	ex, err := models.FindExam(context.Background(), tx, examId)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := models.Certificates(
+		models.CertificateWhere.UserID.EQ(userId),
+		models.CertificateWhere.ExamID.EQ(null.IntFrom(examId)),
+	).One(context.Background(), tx)
+	if err == nil {
+		return c, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	c = &models.Certificate{ID: uuid.NewString(), UserID: userId, LinkedCourseID: ex.CourseID, ExamID: null.IntFrom(examId)}
+	err = c.Insert(context.Background(), tx, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// RevokeForExam takes a transaction, the ID of a user and an exam and soft-deletes the certificate issued to the user for the exam, if there is one.
+// This is used when a passed exam gets graded again and isn't passed anymore.
+func RevokeForExam(tx *sql.Tx, userId, examId int) error {
+	_, err := models.Certificates(
+		models.CertificateWhere.UserID.EQ(userId),
+		models.CertificateWhere.ExamID.EQ(null.IntFrom(examId)),
+	).DeleteAll(context.Background(), tx, false)
+	return err
+}
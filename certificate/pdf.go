@@ -0,0 +1,109 @@
+package certificate
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+
+	"github.com/boombuler/barcode/qr"
+	"github.com/go-pdf/fpdf"
+	"github.com/go-pdf/fpdf/contrib/barcode"
+	"learningbay24.de/backend/config"
+	"learningbay24.de/backend/dbi"
+)
+
+type pdfLabels struct {
+	Title      string
+	Certifies  string
+	Completed  string
+	PassedExam string
+	IssuedOn   string
+	DateFormat string
+	VerifyAt   string
+}
+
+// labels of each language, languages without labels fall back to German
+var labels = map[int]pdfLabels{
+	dbi.GermanLanguageId: {
+		Title:      "Zertifikat",
+		Certifies:  "Hiermit wird bestätigt, dass",
+		Completed:  "den Kurs",
+		PassedExam: "durch Bestehen der Prüfung \"%s\" erfolgreich abgeschlossen hat.",
+		IssuedOn:   "Ausgestellt am %s",
+		DateFormat: "02.01.2006",
+		VerifyAt:   "Die Echtheit dieses Zertifikats kann unter folgender Adresse überprüft werden:",
+	},
+	dbi.EnglishLanguageId: {
+		Title:      "Certificate",
+		Certifies:  "This is to certify that",
+		Completed:  "has successfully completed the course",
+		PassedExam: "by passing the exam \"%s\".",
+		IssuedOn:   "Issued on %s",
+		DateFormat: "January 2, 2006",
+		VerifyAt:   "The authenticity of this certificate can be verified at:",
+	},
+}
+
+// VerificationURL takes the ID of a certificate and returns the public URL it can be verified at
+func VerificationURL(id string) string {
+	return config.BaseURL() + "/certificates/" + id + "/verify"
+}
+
+// WritePDF takes the ID of a certificate and writes it as a PDF in the language preferred by its holder to w.
+// The PDF contains a QR code linking to the public verification of the certificate.
+func WritePDF(db *sql.DB, id string, w io.Writer) error {
+	v, err := Verify(db, id)
+	if err != nil {
+		return err
+	}
+
+	l, ok := labels[v.languageId]
+	if !ok {
+		l = labels[dbi.GermanLanguageId]
+	}
+
+	pdf := fpdf.New("L", "mm", "A4", "")
+	// the core fonts only support cp1252
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pdf.SetTitle(l.Title+" - "+v.Course, true)
+	pdf.SetAuthor("LearningBay24", true)
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.AddPage()
+
+	width, height := pdf.GetPageSize()
+	pdf.SetLineWidth(1)
+	pdf.Rect(10, 10, width-20, height-20, "D")
+
+	pdf.SetY(35)
+	pdf.SetFont("Helvetica", "B", 36)
+	pdf.CellFormat(0, 16, tr(l.Title), "", 1, "C", false, 0, "")
+
+	pdf.Ln(10)
+	pdf.SetFont("Helvetica", "", 16)
+	pdf.CellFormat(0, 10, tr(l.Certifies), "", 1, "C", false, 0, "")
+	pdf.SetFont("Helvetica", "B", 24)
+	pdf.CellFormat(0, 14, tr(v.Holder), "", 1, "C", false, 0, "")
+	pdf.SetFont("Helvetica", "", 16)
+	pdf.CellFormat(0, 10, tr(l.Completed), "", 1, "C", false, 0, "")
+	pdf.SetFont("Helvetica", "B", 20)
+	pdf.CellFormat(0, 12, tr(v.Course), "", 1, "C", false, 0, "")
+	if v.Exam.Valid {
+		pdf.SetFont("Helvetica", "", 16)
+		pdf.CellFormat(0, 10, tr(fmt.Sprintf(l.PassedExam, v.Exam.String)), "", 1, "C", false, 0, "")
+	}
+
+	pdf.Ln(8)
+	pdf.SetFont("Helvetica", "", 12)
+	pdf.CellFormat(0, 8, tr(fmt.Sprintf(l.IssuedOn, v.IssuedAt.Format(l.DateFormat))), "", 1, "C", false, 0, "")
+
+	url := VerificationURL(v.ID)
+	key := barcode.RegisterQR(pdf, url, qr.M, qr.Unicode)
+	size := 35.0
+	barcode.Barcode(pdf, key, width-20-size, height-20-size, size, size, false)
+
+	pdf.SetFont("Helvetica", "", 9)
+	pdf.SetXY(20, height-20-size+12)
+	pdf.MultiCell(width-60-size, 5, tr(l.VerifyAt)+"\n"+url, "", "L", false)
+
+	return pdf.Output(w)
+}
@@ -0,0 +1,49 @@
+package certificate
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"learningbay24.de/backend/models"
+)
+
+// Verification contains everything needed to verify a certificate without further access to the platform
+type Verification struct {
+	ID       string      `json:"id"`
+	Holder   string      `json:"holder"`
+	Course   string      `json:"course"`
+	Exam     null.String `json:"exam"`
+	IssuedAt time.Time   `json:"issued_at"`
+	// the language preferred by the holder, not part of the public verification
+	languageId int
+}
+
+// Verify takes the ID of a certificate and returns the details needed to verify it.
+// Revoked certificates can't be verified anymore.
+func Verify(db *sql.DB, id string) (*Verification, error) {
+	c, err := models.FindCertificate(context.Background(), db, id)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := models.FindUser(context.Background(), db, c.UserID)
+	if err != nil {
+		return nil, err
+	}
+	co, err := models.FindCourse(context.Background(), db, c.LinkedCourseID)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &Verification{ID: c.ID, Holder: u.Firstname + " " + u.Surname, Course: co.Name, IssuedAt: c.CreatedAt.Time, languageId: u.PreferredLanguageID}
+	if c.ExamID.Valid {
+		ex, err := models.FindExam(context.Background(), db, c.ExamID.Int)
+		if err != nil {
+			return nil, err
+		}
+		v.Exam = null.StringFrom(ex.Name)
+	}
+	return v, nil
+}
@@ -22,12 +22,64 @@ type Files struct {
 	Path             string
 	AllowedFileTypes []string
 	MaxUploadPerUser int
+	// backend new files are stored in, either "local" or "s3"
+	Storage string
+	// number of previews generated at the same time, 0 = no previews
+	PreviewWorkers int
+}
+
+// S3 is an S3 compatible object storage files can be stored in
+type S3 struct {
+	Endpoint  string
+	AccessKey string
+	SecretKey string
+	Bucket    string
+	Region    string
+	// connect using https
+	Secure bool
+}
+
+// Scanner is the virus scanner uploaded files are checked with
+type Scanner struct {
+	// address of a ClamAV daemon, either "host:port" or "unix:" followed by the path of its socket. Files aren't scanned if empty.
+	Clamd string
+	// seconds a scan may take at most, 0 = no limit
+	TimeoutSeconds int
+}
+
+type Sessions struct {
+	// minutes an access token is valid, 0 = 15 minutes
+	AccessTokenMinutes int
+	// days a session lasts unless its refresh token is used, 0 = 30 days
+	RefreshTokenDays int
 }
 
 type Secrets struct {
 	JWTSecret string
 }
 
+type Mail struct {
+	// mails are only sent if a host is set
+	Host string
+	Port int
+	User string
+	Pass string
+	From string
+	// hour of the day at which the daily digests are sent
+	DigestHour int
+	// page of the frontend the links in password reset mails lead to, the token is added as query parameter `token`. Passwords can't be reset if empty.
+	ResetURL string
+}
+
+type Janitor struct {
+	// hours between two runs, 0 = disabled
+	IntervalHours int
+	// days deleted files are kept before they are removed for good
+	RetentionDays int
+	// only report what would be removed
+	DryRun bool
+}
+
 type Config struct {
 	Domain      string
 	Secure      bool
@@ -36,15 +88,30 @@ type Config struct {
 	AdminPass   string
 	DB          DB
 	Files       Files
+	S3          S3
+	Scanner     Scanner
 	Secrets     Secrets
+	Sessions    Sessions
+	Mail        Mail
+	Janitor     Janitor
+	// recompute the bytes uploaded by every user and exit, set by -r
+	RepairQuotas bool `toml:"-"`
 }
 
 var (
 	Conf Config
 )
 
+// BaseURL returns the URL of the site as configured by Domain and Secure
+func BaseURL() string {
+	if Conf.Secure {
+		return "https://" + Conf.Domain
+	}
+	return "http://" + Conf.Domain
+}
+
 func parseCLI() {
-	opts, _, err := getopt.Getopts(os.Args, "v")
+	opts, _, err := getopt.Getopts(os.Args, "vr")
 	if err != nil {
 		log.Fatalf("Unable to parse command line arguments: %s\n", err.Error())
 	}
@@ -53,6 +120,8 @@ func parseCLI() {
 		switch opt.Option {
 		case 'v':
 			Conf.LogLevel = "debug"
+		case 'r':
+			Conf.RepairQuotas = true
 		}
 	}
 }
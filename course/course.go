@@ -208,6 +208,22 @@ func DeleteCourse(db *sql.DB, id int) (int, error) {
 
 	}
 
+	err = deletePrerequisites(tx, id)
+	if err != nil {
+		if e := tx.Rollback(); e != nil {
+			return 0, fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+		}
+		return 0, err
+	}
+
+	_, err = models.FieldOfStudyHasCourses(models.FieldOfStudyHasCourseWhere.CourseID.EQ(id)).DeleteAll(context.Background(), tx)
+	if err != nil {
+		if e := tx.Rollback(); e != nil {
+			return 0, fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+		}
+		return 0, err
+	}
+
 	_, err = c.Delete(context.Background(), tx, true)
 	if err != nil {
 		if e := tx.Rollback(); e != nil {
@@ -294,6 +310,7 @@ func DeleteUserFromCourse(db *sql.DB, uid int, cid int) error {
 }
 
 // EnrollUser takes a UserID, CourseID and Enrollkey and adds the User to the course if the enrollkey is correct
+// and the User holds a certificate of every course required by it
 func EnrollUser(db *sql.DB, uid int, cid int, enrollkey string) (*models.User, error) {
 
 	tx, err := db.BeginTx(context.Background(), nil)
@@ -318,6 +335,21 @@ func EnrollUser(db *sql.DB, uid int, cid int, enrollkey string) (*models.User, e
 		return nil, errors.New("wrong Enrollkey")
 
 	}
+	missing, err := missingPrerequisites(tx, uid, cid)
+	if err != nil {
+		if e := tx.Rollback(); e != nil {
+			return nil, fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err, e)
+		}
+
+		return nil, err
+	}
+	if len(missing) > 0 {
+		if e := tx.Rollback(); e != nil {
+			return nil, fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err, e)
+		}
+
+		return nil, &MissingPrerequisitesError{Courses: missing}
+	}
 	userhascourse := models.UserHasCourse{UserID: uid, CourseID: cid, RoleID: dbi.CourseUserRoleId}
 	err = userhascourse.Insert(context.Background(), tx, boil.Infer())
 	if err != nil {
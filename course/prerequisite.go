@@ -0,0 +1,137 @@
+package course
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"learningbay24.de/backend/models"
+)
+
+// MissingPrerequisitesError is returned when a user lacks the certificates required to enroll in a course
+type MissingPrerequisitesError struct {
+	Courses models.CourseSlice
+}
+
+func (e *MissingPrerequisitesError) Error() string {
+	names := make([]string, len(e.Courses))
+	for i, c := range e.Courses {
+		names[i] = c.Name
+	}
+	return "missing certificates of required courses: " + strings.Join(names, ", ")
+}
+
+// GetPrerequisites takes the ID of a course and returns all courses a certificate is required of in order to enroll in it
+func GetPrerequisites(db *sql.DB, courseId int) (models.CourseSlice, error) {
+	c, err := models.FindCourse(context.Background(), db, courseId)
+	if err != nil {
+		return nil, err
+	}
+
+	required, err := c.RequiredCourseCourses().All(context.Background(), db)
+	if err != nil {
+		return nil, err
+	}
+	return required, nil
+}
+
+// AddPrerequisite takes the ID of a course and the ID of a required course and adds the required course to the prerequisites of the course
+func AddPrerequisite(db *sql.DB, courseId, requiredCourseId int) error {
+	if courseId == requiredCourseId {
+		return errors.New("a course can't require itself")
+	}
+
+	c, err := models.FindCourse(context.Background(), db, courseId)
+	if err != nil {
+		return err
+	}
+	required, err := models.FindCourse(context.Background(), db, requiredCourseId)
+	if err != nil {
+		return err
+	}
+
+	// a cycle would make it impossible to enroll in any of the courses involved
+	cyclic, err := requiresCourse(db, requiredCourseId, courseId, map[int]bool{})
+	if err != nil {
+		return err
+	}
+	if cyclic {
+		return errors.New("the required course already requires this course")
+	}
+
+	return c.AddRequiredCourseCourses(context.Background(), db, false, required)
+}
+
+// RemovePrerequisite takes the ID of a course and the ID of a required course and removes the required course from the prerequisites of the course
+func RemovePrerequisite(db *sql.DB, courseId, requiredCourseId int) error {
+	c, err := models.FindCourse(context.Background(), db, courseId)
+	if err != nil {
+		return err
+	}
+
+	return c.RemoveRequiredCourseCourses(context.Background(), db, &models.Course{ID: requiredCourseId})
+}
+
+// GetMissingPrerequisites takes the ID of a user and a course and returns all required courses of the course the user holds no certificate of
+func GetMissingPrerequisites(db *sql.DB, userId, courseId int) (models.CourseSlice, error) {
+	return missingPrerequisites(db, userId, courseId)
+}
+
+func missingPrerequisites(exec boil.ContextExecutor, userId, courseId int) (models.CourseSlice, error) {
+	c, err := models.FindCourse(context.Background(), exec, courseId)
+	if err != nil {
+		return nil, err
+	}
+
+	required, err := c.RequiredCourseCourses().All(context.Background(), exec)
+	if err != nil {
+		return nil, err
+	}
+
+	var missing models.CourseSlice
+	for _, r := range required {
+		held, err := models.Certificates(
+			models.CertificateWhere.UserID.EQ(userId),
+			models.CertificateWhere.LinkedCourseID.EQ(r.ID),
+		).Exists(context.Background(), exec)
+		if err != nil {
+			return nil, err
+		}
+		if !held {
+			missing = append(missing, r)
+		}
+	}
+	return missing, nil
+}
+
+// requiresCourse takes the ID of a course and another course and reports whether the first one requires the second one, directly or through other courses
+func requiresCourse(db *sql.DB, courseId, requiredCourseId int, visited map[int]bool) (bool, error) {
+	if visited[courseId] {
+		return false, nil
+	}
+	visited[courseId] = true
+
+	required, err := GetPrerequisites(db, courseId)
+	if err != nil {
+		return false, err
+	}
+	for _, r := range required {
+		if r.ID == requiredCourseId {
+			return true, nil
+		}
+		found, err := requiresCourse(db, r.ID, requiredCourseId, visited)
+		if err != nil || found {
+			return found, err
+		}
+	}
+	return false, nil
+}
+
+// deletePrerequisites removes a course from all prerequisite lists, as well as its own prerequisites
+func deletePrerequisites(tx *sql.Tx, courseId int) error {
+	_, err := queries.Raw("DELETE FROM course_requires_certificate WHERE course_id = ? OR required_course_id = ?", courseId, courseId).ExecContext(context.Background(), tx)
+	return err
+}
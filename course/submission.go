@@ -6,13 +6,18 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"learningbay24.de/backend/config"
 	"learningbay24.de/backend/dbi"
 	"learningbay24.de/backend/models"
+	"learningbay24.de/backend/notification"
+
+	log "github.com/sirupsen/logrus"
 )
 
 func GetSubmission(db *sql.DB, sid int) (*models.Submission, error) {
@@ -23,7 +28,8 @@ func GetSubmission(db *sql.DB, sid int) (*models.Submission, error) {
 	return s, nil
 }
 
-func CreateSubmission(db *sql.DB, name string, deadline string, cid int, maxfilesize int, visiblefrom string) (int, error) {
+// CreateSubmission creates a submission in a course. If allowedFileTypes isn't empty, solutions can only be uploaded as files of these types.
+func CreateSubmission(db *sql.DB, name string, deadline string, cid int, maxfilesize int, visiblefrom string, allowedFileTypes []string) (int, error) {
 
 	var dtime null.Time
 	var parseddtime time.Time
@@ -66,7 +72,12 @@ func CreateSubmission(db *sql.DB, name string, deadline string, cid int, maxfile
 		}
 	}
 
-	s := &models.Submission{Name: name, Deadline: dtime, CourseID: cid, MaxFilesize: maxfilesize, VisibleFrom: vtime}
+	types, err := allowedFileTypesToString(allowedFileTypes)
+	if err != nil {
+		return 0, err
+	}
+
+	s := &models.Submission{Name: name, Deadline: dtime, CourseID: cid, MaxFilesize: maxfilesize, VisibleFrom: vtime, AllowedFileTypes: types}
 
 	// Inserts into database
 	err = s.Insert(context.Background(), db, boil.Infer())
@@ -77,7 +88,8 @@ func CreateSubmission(db *sql.DB, name string, deadline string, cid int, maxfile
 	return s.ID, nil
 }
 
-func EditSubmission(db *sql.DB, sid int, name string, deadline string, maxfilesize int, visiblefrom string) (int, error) {
+// EditSubmission overwrites the values of a submission. An empty allowedFileTypes allows all globally allowed file types.
+func EditSubmission(db *sql.DB, sid int, name string, deadline string, maxfilesize int, visiblefrom string, allowedFileTypes []string) (int, error) {
 
 	var dtime null.Time
 	var parseddtime time.Time
@@ -120,6 +132,11 @@ func EditSubmission(db *sql.DB, sid int, name string, deadline string, maxfilesi
 		}
 	}
 
+	types, err := allowedFileTypesToString(allowedFileTypes)
+	if err != nil {
+		return 0, err
+	}
+
 	// Begins the transaction
 	tx, err := db.BeginTx(context.Background(), nil)
 	if err != nil {
@@ -134,6 +151,7 @@ func EditSubmission(db *sql.DB, sid int, name string, deadline string, maxfilesi
 	s.Deadline = dtime
 	s.VisibleFrom = vtime
 	s.MaxFilesize = maxfilesize
+	s.AllowedFileTypes = types
 
 	_, err = s.Update(context.Background(), tx, boil.Infer())
 	if err != nil {
@@ -203,17 +221,22 @@ func DeleteSubmissionHasFiles(db *sql.DB, submission_id int, file_id int) error
 		if e := tx.Rollback(); e != nil {
 			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
 		}
+		return err
 	}
 
-	_, err = file.Delete(context.Background(), tx, false)
+	err = dbi.DeleteFile(tx, file)
 	if err != nil {
 		if e := tx.Rollback(); e != nil {
 			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
 		}
+		return err
 	}
 
 	_, err = tx.Exec("DELETE FROM submission_has_files WHERE submission_id = ? AND file_id = ? ;", submission_id, file_id)
 	if err != nil {
+		if e := tx.Rollback(); e != nil {
+			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+		}
 		return err
 	}
 	if e := tx.Commit(); e != nil {
@@ -283,9 +306,28 @@ func CreateUserSubmission(db *sql.DB, name string, submitter_id int, submission_
 		return 0, fmt.Errorf("fatal: unable to commit transaction on error: %s; %s", err, e)
 	}
 
+	notifySubmissionCreated(db, submitter_id, submission_id)
+
 	return uhassubmission.ID, nil
 }
 
+// notifySubmissionCreated notifies the moderators of a course that a user handed in a submission.
+// Failing to notify doesn't fail the submission, so errors are only logged.
+func notifySubmissionCreated(db *sql.DB, submitter_id int, submission_id int) {
+	submission, err := GetSubmission(db, submission_id)
+	if err != nil {
+		log.Errorf("Unable to notify about new user submission: %s", err.Error())
+		return
+	}
+
+	err = notification.NotifyCourse(db, submission.CourseID, dbi.CourseModeratorRoleId, submitter_id,
+		"New submission", fmt.Sprintf("A new submission has been handed in for \"%s\".", submission.Name),
+		fmt.Sprintf("/courses/%d/submissions", submission.CourseID))
+	if err != nil {
+		log.Errorf("Unable to notify about new user submission: %s", err.Error())
+	}
+}
+
 /*
 func EditUserSubmission(db *sql.DB, user_submission_id int, file_id int, name string) (int, error) {
 	tx, err := db.BeginTx(context.Background(), nil)
@@ -352,6 +394,20 @@ func DeleteUserSubmission(db *sql.DB, user_submission_id int, user_id int) (int,
 }
 
 func CreateUserSubmissionHasFiles(db *sql.DB, user_submission_id int, fileName string, uri string, uploaderId int, local bool, file io.Reader, fileSize int) error {
+	if local {
+		limits, err := GetUploadLimitsOfUserSubmission(db, user_submission_id)
+		if err != nil {
+			return err
+		}
+		if limits.MaxFilesize != 0 && int64(fileSize) > limits.MaxFilesize {
+			return dbi.FileTooLarge(limits.MaxFilesize)
+		}
+		file, err = dbi.CheckFileType(fileName, file, limits.AllowedFileTypes)
+		if err != nil {
+			return err
+		}
+	}
+
 	tx, err := db.BeginTx(context.Background(), nil)
 	if err != nil {
 		return err
@@ -392,17 +448,22 @@ func DeleteUserSubmissionHasFiles(db *sql.DB, user_submission_id int, file_id in
 		if e := tx.Rollback(); e != nil {
 			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
 		}
+		return err
 	}
 
-	_, err = file.Delete(context.Background(), tx, false)
+	err = dbi.DeleteFile(tx, file)
 	if err != nil {
 		if e := tx.Rollback(); e != nil {
 			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
 		}
+		return err
 	}
 
 	_, err = tx.Exec("DELETE FROM user_submission_has_files WHERE user_submission_id = ? AND file_id = ? ;", user_submission_id, file_id)
 	if err != nil {
+		if e := tx.Rollback(); e != nil {
+			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+		}
 		return err
 	}
 	if e := tx.Commit(); e != nil {
@@ -488,3 +549,54 @@ func GetCourseIdByUserSubmission(db *sql.DB, user_submission_id int) (int, error
 	}
 	return submission.CourseID, nil
 }
+
+// UploadLimits are the restrictions on files uploaded as solutions to a submission
+type UploadLimits struct {
+	// in bytes, 0 if unlimited
+	MaxFilesize      int64    `json:"max_filesize"`
+	AllowedFileTypes []string `json:"allowed_file_types"`
+}
+
+// GetUploadLimits takes the ID of a submission and returns the restrictions on files uploaded as solutions to it.
+// If the submission doesn't narrow the allowed file types down, the globally allowed ones are returned.
+func GetUploadLimits(db *sql.DB, submission_id int) (*UploadLimits, error) {
+	s, err := GetSubmission(db, submission_id)
+	if err != nil {
+		return nil, err
+	}
+
+	limits := &UploadLimits{AllowedFileTypes: config.Conf.Files.AllowedFileTypes}
+	// max_filesize is stored in MB
+	if s.MaxFilesize > 0 {
+		limits.MaxFilesize = int64(s.MaxFilesize) << 20
+	}
+	if s.AllowedFileTypes.Valid && s.AllowedFileTypes.String != "" {
+		limits.AllowedFileTypes = strings.Split(s.AllowedFileTypes.String, ",")
+	}
+	if limits.AllowedFileTypes == nil {
+		limits.AllowedFileTypes = []string{}
+	}
+
+	return limits, nil
+}
+
+// GetUploadLimitsOfUserSubmission takes the ID of a user submission and returns the restrictions on its files
+func GetUploadLimitsOfUserSubmission(db *sql.DB, user_submission_id int) (*UploadLimits, error) {
+	us, err := GetUserSubmission(db, user_submission_id)
+	if err != nil {
+		return nil, err
+	}
+	return GetUploadLimits(db, us.SubmissionID)
+}
+
+// allowedFileTypesToString takes a list of file types and returns them in the format stored in the database
+func allowedFileTypesToString(allowedFileTypes []string) (null.String, error) {
+	types, err := dbi.NormalizeFileTypes(allowedFileTypes)
+	if err != nil {
+		return null.String{}, err
+	}
+	if len(types) == 0 {
+		return null.String{}, nil
+	}
+	return null.StringFrom(strings.Join(types, ",")), nil
+}
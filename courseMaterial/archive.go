@@ -0,0 +1,152 @@
+package coursematerial
+
+import (
+	"archive/zip"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"learningbay24.de/backend/models"
+	"learningbay24.de/backend/storage"
+)
+
+// ArchiveEntry is a single file inside a ZIP archive of course materials
+type ArchiveEntry struct {
+	// path of the file inside the archive
+	Name string
+	File *models.File
+}
+
+// GetCourseArchive takes the ID of a course and returns the entries of an archive containing all of its materials.
+// Files in a directory are put into a folder named after it. Directories that aren't visible yet are only included if includeHidden is set.
+func GetCourseArchive(db *sql.DB, courseId int, includeHidden bool) ([]ArchiveEntry, error) {
+	files, err := GetAllMaterialsFromCourse(db, courseId)
+	if err != nil {
+		return nil, err
+	}
+
+	directories, err := GetDirectoriesFromCourse(db, courseId, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// maps the ID of a file to the directory it is in
+	fileDirectories := map[int]*models.Directory{}
+	for _, d := range directories {
+		dirFiles, err := d.Files().All(context.Background(), db)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range dirFiles {
+			fileDirectories[f.ID] = d
+		}
+	}
+
+	names := map[string]bool{}
+	var entries []ArchiveEntry
+	for _, f := range files {
+		if f.DeletedAt.Valid {
+			continue
+		}
+
+		folder := ""
+		if d, ok := fileDirectories[f.ID]; ok {
+			if !includeHidden && !isDirectoryVisible(d) {
+				continue
+			}
+			folder = archiveName(d.Name) + "/"
+		}
+
+		entries = append(entries, ArchiveEntry{Name: uniqueArchiveName(names, folder+archiveFileName(f)), File: f})
+	}
+
+	return entries, nil
+}
+
+// GetDirectoryArchive takes the ID of a course and a directory and returns the entries of an archive containing all files in the directory.
+// The files of a directory that isn't visible yet are only returned if includeHidden is set.
+func GetDirectoryArchive(db *sql.DB, courseId, directoryId int, includeHidden bool) ([]ArchiveEntry, error) {
+	files, err := GetMaterialsFromDirectory(db, courseId, directoryId, includeHidden)
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]bool{}
+	var entries []ArchiveEntry
+	for _, f := range files {
+		entries = append(entries, ArchiveEntry{Name: uniqueArchiveName(names, archiveFileName(f)), File: f})
+	}
+
+	return entries, nil
+}
+
+// WriteArchive takes a writer and the entries of an archive and writes them as a ZIP archive.
+// Local files are streamed from disk one after another, remote files are written as `.url` shortcuts.
+func WriteArchive(w io.Writer, entries []ArchiveEntry) error {
+	zw := zip.NewWriter(w)
+
+	for _, e := range entries {
+		fw, err := zw.CreateHeader(&zip.FileHeader{Name: e.Name, Method: zip.Deflate, Modified: e.File.CreatedAt})
+		if err != nil {
+			return err
+		}
+
+		if e.File.Local == 0 {
+			_, err = fmt.Fprintf(fw, "[InternetShortcut]\r\nURL=%s\r\n", e.File.URI)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = copyFile(fw, e.File.URI)
+		if err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+func copyFile(w io.Writer, uri string) error {
+	fp, err := storage.Get(context.Background(), uri)
+	if err != nil {
+		return err
+	}
+	defer fp.Close()
+
+	_, err = io.Copy(w, fp)
+	return err
+}
+
+// archiveFileName returns the name of a file inside an archive, with remote files getting the `.url` extension
+func archiveFileName(f *models.File) string {
+	name := archiveName(f.Name)
+	if f.Local == 0 {
+		name += ".url"
+	}
+	return name
+}
+
+// archiveName replaces characters that would otherwise be interpreted as folders inside an archive
+func archiveName(name string) string {
+	name = strings.NewReplacer("/", "_", "\\", "_").Replace(strings.TrimSpace(name))
+	if name == "" || name == "." || name == ".." {
+		name = "_"
+	}
+	return name
+}
+
+// uniqueArchiveName appends a suffix of "-<count>" to a name that is already taken inside an archive, like files on disk
+func uniqueArchiveName(taken map[string]bool, name string) string {
+	unique := name
+	for num := 1; taken[unique]; num++ {
+		ext := path.Ext(name)
+		unique = fmt.Sprintf("%s-%d%s", strings.TrimSuffix(name, ext), num, ext)
+	}
+	taken[unique] = true
+	return unique
+}
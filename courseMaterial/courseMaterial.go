@@ -5,22 +5,37 @@ import (
 	"database/sql"
 	"fmt"
 	"io"
+	"time"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"learningbay24.de/backend/dbi"
 	"learningbay24.de/backend/models"
+	"learningbay24.de/backend/notification"
+
+	log "github.com/sirupsen/logrus"
 )
 
-// GetMaterialFromCourse takes an ID and returns a struct of the file with the corresponding ID
-func GetMaterialFromCourse(db *sql.DB, courseId int, fileId int) (*models.File, error) {
-	// TODO: use courseId to verify that the file is indeed in that course
-	cm, err := models.FindFile(context.Background(), db, fileId)
+// GetMaterialFromCourse takes the ID of a course and of a file and returns the file, or sql.ErrNoRows if it doesn't belong to the course.
+// Files in directories that aren't visible yet are only returned if includeHidden is set.
+func GetMaterialFromCourse(db *sql.DB, courseId int, fileId int, includeHidden bool) (*models.File, error) {
+	_, err := models.FindCourseHasFile(context.Background(), db, courseId, fileId)
 	if err != nil {
 		return nil, err
 	}
-	return cm, err
+
+	if !includeHidden {
+		hidden, err := (&models.File{ID: fileId}).Directories(models.DirectoryWhere.VisibleFrom.GT(time.Now())).Exists(context.Background(), db)
+		if err != nil {
+			return nil, err
+		}
+		if hidden {
+			return nil, sql.ErrNoRows
+		}
+	}
+
+	return models.FindFile(context.Background(), db, fileId)
 }
 
 // GetAllMaterialsFromCourse takes a courseID and returns a slice of files associated with it
@@ -38,21 +53,50 @@ func GetAllMaterialsFromCourse(db *sql.DB, courseId int) ([]*models.File, error)
 // CreateMaterial takes a fileName, URI, associated uploader-id, course, id and indicator if file is local or remote
 // Created struct gets inserted into database
 func CreateMaterial(dbHandle *sql.DB, fileName string, uri string, uploaderId, courseId int, local bool, file io.Reader, fileSize int) error {
-	fileId, err := dbi.SaveFile(dbHandle, fileName, uri, uploaderId, local, &file, fileSize)
+	_, err := createMaterial(dbHandle, fileName, uri, uploaderId, courseId, local, file, fileSize)
 	if err != nil {
 		return err
 	}
 
+	notifyMaterialCreated(dbHandle, uploaderId, courseId, fileName)
+
+	return nil
+}
+
+// createMaterial saves a file and adds it to a course without notifying anyone and returns the ID of the file
+func createMaterial(dbHandle *sql.DB, fileName string, uri string, uploaderId, courseId int, local bool, file io.Reader, fileSize int) (int, error) {
+	fileId, err := dbi.SaveFile(dbHandle, fileName, uri, uploaderId, local, &file, fileSize)
+	if err != nil {
+		return 0, err
+	}
+
 	chf := models.CourseHasFile{
 		CourseID: courseId, FileID: fileId,
 	}
 
 	err = chf.Insert(context.Background(), dbHandle, boil.Infer())
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	return fileId, nil
+}
+
+// notifyMaterialCreated notifies the members of a course about newly uploaded material.
+// Failing to notify doesn't fail the upload, so errors are only logged.
+func notifyMaterialCreated(db *sql.DB, uploaderId, courseId int, fileName string) {
+	c, err := models.FindCourse(context.Background(), db, courseId)
+	if err != nil {
+		log.Errorf("Unable to notify about new course material: %s", err.Error())
+		return
+	}
+
+	err = notification.NotifyCourse(db, courseId, dbi.CourseUserRoleId, uploaderId,
+		"New course material", fmt.Sprintf("\"%s\" has been uploaded to %s.", fileName, c.Name),
+		fmt.Sprintf("/courses/%d/files", courseId))
+	if err != nil {
+		log.Errorf("Unable to notify about new course material: %s", err.Error())
+	}
 }
 
 // DeleteMaterialFromCourse takes both course-ID and file-ID and soft-deletes the chosen material
@@ -67,13 +111,15 @@ func DeleteMaterialFromCourse(db *sql.DB, courseId, fileId int) error {
 		if e := tx.Rollback(); e != nil {
 			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
 		}
+		return err
 	}
 
-	_, err = cm.Delete(context.Background(), tx, false)
+	err = dbi.DeleteFile(tx, cm)
 	if err != nil {
 		if e := tx.Rollback(); e != nil {
 			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
 		}
+		return err
 	}
 
 	chf, err := models.FindCourseHasFile(context.Background(), tx, courseId, fileId)
@@ -81,6 +127,7 @@ func DeleteMaterialFromCourse(db *sql.DB, courseId, fileId int) error {
 		if e := tx.Rollback(); e != nil {
 			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
 		}
+		return err
 	}
 
 	_, err = chf.Delete(context.Background(), tx, false)
@@ -88,6 +135,7 @@ func DeleteMaterialFromCourse(db *sql.DB, courseId, fileId int) error {
 		if e := tx.Rollback(); e != nil {
 			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
 		}
+		return err
 	}
 
 	if e := tx.Commit(); e != nil {
@@ -97,7 +145,7 @@ func DeleteMaterialFromCourse(db *sql.DB, courseId, fileId int) error {
 	return nil
 }
 
-// DeleteAllMaterialsFromCourse takes the ID of a course and deactivates all files associated with it
+// DeleteAllMaterialsFromCourse takes the ID of a course and deactivates all files associated with it, or removes them for good if hardDelete is set
 // TODO: compability with transactions
 func DeleteAllMaterialsFromCourse(db *sql.DB, courseId int, hardDelete bool) error {
 	tx, err := db.BeginTx(context.Background(), nil)
@@ -115,7 +163,46 @@ func DeleteAllMaterialsFromCourse(db *sql.DB, courseId int, hardDelete bool) err
 		return err
 	}
 
-	_, err = materials.DeleteAll(context.Background(), tx, hardDelete)
+	if !hardDelete {
+		for _, m := range materials {
+			err = dbi.DeleteFile(tx, m)
+			if err != nil {
+				if e := tx.Rollback(); e != nil {
+					return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err, e)
+				}
+
+				return err
+			}
+		}
+
+		if e := tx.Commit(); e != nil {
+			return fmt.Errorf("fatal: unable to commit transaction on error: %s; %s", err, e)
+		}
+		return nil
+	}
+
+	_, err = models.CourseHasFiles(models.CourseHasFileWhere.CourseID.EQ(courseId)).DeleteAll(context.Background(), tx, true)
+	if err != nil {
+		if e := tx.Rollback(); e != nil {
+			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err, e)
+		}
+
+		return err
+	}
+
+	for _, m := range materials {
+		err = m.SetDirectories(context.Background(), tx, false)
+		if err != nil {
+			if e := tx.Rollback(); e != nil {
+				return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err, e)
+			}
+
+			return err
+		}
+	}
+
+	// content shared with files of other courses is kept
+	unused, err := dbi.HardDeleteFiles(tx, materials)
 	if err != nil {
 		if e := tx.Rollback(); e != nil {
 			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err, e)
@@ -127,6 +214,8 @@ func DeleteAllMaterialsFromCourse(db *sql.DB, courseId int, hardDelete bool) err
 	if e := tx.Commit(); e != nil {
 		return fmt.Errorf("fatal: unable to commit transaction on error: %s; %s", err, e)
 	}
+	dbi.RemoveContent(db, unused)
+
 	return nil
 }
 
@@ -0,0 +1,196 @@
+package coursematerial
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"learningbay24.de/backend/models"
+)
+
+// GetDirectoriesFromCourse takes the ID of a course and returns its directories ordered by the time they become visible.
+// Directories that aren't visible yet are only included if includeHidden is set.
+func GetDirectoriesFromCourse(db *sql.DB, courseId int, includeHidden bool) (models.DirectorySlice, error) {
+	mods := []qm.QueryMod{
+		models.DirectoryWhere.CourseID.EQ(courseId),
+		qm.OrderBy(models.DirectoryColumns.VisibleFrom + " ASC, " + models.DirectoryColumns.Name + " ASC"),
+	}
+	if !includeHidden {
+		mods = append(mods, models.DirectoryWhere.VisibleFrom.LTE(time.Now()))
+	}
+
+	directories, err := models.Directories(mods...).All(context.Background(), db)
+	if err != nil {
+		return nil, err
+	}
+	return directories, nil
+}
+
+// GetDirectory takes the ID of a course and a directory and returns the directory, if it belongs to the course.
+// A directory that isn't visible yet is only returned if includeHidden is set, otherwise sql.ErrNoRows is returned like for a directory that doesn't exist.
+func GetDirectory(db *sql.DB, courseId, directoryId int, includeHidden bool) (*models.Directory, error) {
+	d, err := models.FindDirectory(context.Background(), db, directoryId)
+	if err != nil {
+		return nil, err
+	}
+	if d.CourseID != courseId || (!includeHidden && !isDirectoryVisible(d)) {
+		return nil, sql.ErrNoRows
+	}
+	return d, nil
+}
+
+// GetMaterialsFromDirectory takes the ID of a course and a directory and returns all files in the directory.
+// The files of a directory that isn't visible yet are only returned if includeHidden is set.
+func GetMaterialsFromDirectory(db *sql.DB, courseId, directoryId int, includeHidden bool) (models.FileSlice, error) {
+	d, err := GetDirectory(db, courseId, directoryId, includeHidden)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := d.Files(qm.OrderBy(models.FileColumns.Name+" ASC")).All(context.Background(), db)
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// CreateDirectory takes the ID of a course, a name and the time from which on the directory is visible to students and creates the directory
+func CreateDirectory(db *sql.DB, courseId int, name string, visibleFrom time.Time) (int, error) {
+	if strings.TrimSpace(name) == "" {
+		return 0, errors.New("name can't be empty")
+	}
+
+	d := &models.Directory{Name: name, CourseID: courseId, VisibleFrom: visibleFrom}
+	err := d.Insert(context.Background(), db, boil.Infer())
+	if err != nil {
+		return 0, err
+	}
+	return d.ID, nil
+}
+
+// EditDirectory takes the ID of a course and a directory, a name and the time from which on the directory is visible and overwrites them.
+// An empty name or invalid time keeps the old value.
+func EditDirectory(db *sql.DB, courseId, directoryId int, name string, visibleFrom null.Time) (*models.Directory, error) {
+	d, err := GetDirectory(db, courseId, directoryId, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(name) != "" {
+		d.Name = name
+	}
+	if visibleFrom.Valid {
+		d.VisibleFrom = visibleFrom.Time
+	}
+
+	_, err = d.Update(context.Background(), db, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// DeleteDirectory takes the ID of a course and a directory and soft-deletes the directory.
+// The files in it aren't deleted, but moved to the top level of the course.
+func DeleteDirectory(db *sql.DB, courseId, directoryId int) error {
+	d, err := GetDirectory(db, courseId, directoryId, true)
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.BeginTx(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = queries.Raw("DELETE FROM directory_has_files WHERE directory_id = ?", d.ID).ExecContext(context.Background(), tx)
+	if err != nil {
+		if e := tx.Rollback(); e != nil {
+			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+		}
+		return err
+	}
+
+	_, err = d.Delete(context.Background(), tx, false)
+	if err != nil {
+		if e := tx.Rollback(); e != nil {
+			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+		}
+		return err
+	}
+
+	if e := tx.Commit(); e != nil {
+		return fmt.Errorf("fatal: unable to commit transaction on error: %s; %s", err, e)
+	}
+	return nil
+}
+
+// CreateMaterialInDirectory takes the same arguments as CreateMaterial and the ID of a directory and uploads the file into that directory.
+// Members of the course are only notified if the directory is already visible.
+func CreateMaterialInDirectory(db *sql.DB, fileName string, uri string, uploaderId, courseId, directoryId int, local bool, file io.Reader, fileSize int) error {
+	d, err := GetDirectory(db, courseId, directoryId, true)
+	if err != nil {
+		return err
+	}
+
+	fileId, err := createMaterial(db, fileName, uri, uploaderId, courseId, local, file, fileSize)
+	if err != nil {
+		return err
+	}
+
+	err = d.AddFiles(context.Background(), db, false, &models.File{ID: fileId})
+	if err != nil {
+		return err
+	}
+
+	if isDirectoryVisible(d) {
+		notifyMaterialCreated(db, uploaderId, courseId, fileName)
+	}
+	return nil
+}
+
+// MoveMaterial takes the ID of a course, a file and a directory and moves the file into the directory.
+// If no directory is given, the file is moved to the top level of the course.
+func MoveMaterial(db *sql.DB, courseId, fileId int, directoryId null.Int) error {
+	_, err := models.FindCourseHasFile(context.Background(), db, courseId, fileId)
+	if err != nil {
+		return errors.New("file doesn't belong to this course")
+	}
+
+	f := &models.File{ID: fileId}
+	if !directoryId.Valid {
+		return f.SetDirectories(context.Background(), db, false)
+	}
+
+	d, err := GetDirectory(db, courseId, directoryId.Int, true)
+	if err != nil {
+		return err
+	}
+	return f.SetDirectories(context.Background(), db, false, d)
+}
+
+// GetVisibleMaterialsFromCourse takes the ID of a course and returns all of its files, except the ones in directories that aren't visible yet
+func GetVisibleMaterialsFromCourse(db *sql.DB, courseId int) ([]*models.File, error) {
+	var files []*models.File
+	err := queries.Raw(`select * from file, course_has_files where course_has_files.course_id=? AND course_has_files.file_id=file.id
+		AND file.id NOT IN (select directory_has_files.file_id from directory_has_files, directory where directory_has_files.directory_id=directory.id AND directory.deleted_at IS NULL AND directory.visible_from > ?)`,
+		courseId, time.Now()).Bind(context.Background(), db, &files)
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// isDirectoryVisible reports whether a directory is visible to students
+func isDirectoryVisible(d *models.Directory) bool {
+	return !d.VisibleFrom.After(time.Now())
+}
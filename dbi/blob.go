@@ -0,0 +1,206 @@
+package dbi
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"learningbay24.de/backend/models"
+	"learningbay24.de/backend/storage"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// spooledFile is the content of an upload, written to a temporary file while being hashed
+type spooledFile struct {
+	*os.File
+	hash string
+	size int64
+}
+
+// spool writes the content of an upload to a temporary file and hashes it on the way, so identical content can be looked up before storing it
+func spool(content io.Reader) (*spooledFile, error) {
+	fp, err := os.CreateTemp("", "learningbay24-upload-*")
+	if err != nil {
+		return nil, err
+	}
+
+	h := sha256.New()
+	size, err := io.Copy(fp, io.TeeReader(content, h))
+	if err == nil {
+		_, err = fp.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		fp.Close()
+		os.Remove(fp.Name())
+		return nil, err
+	}
+
+	return &spooledFile{File: fp, hash: hex.EncodeToString(h.Sum(nil)), size: size}, nil
+}
+
+// Close closes and removes the temporary file
+func (s *spooledFile) Close() error {
+	err := s.File.Close()
+	os.Remove(s.File.Name())
+	return err
+}
+
+// blobKey returns the key the content with the given hash is stored under
+func blobKey(hash string) string {
+	return "blobs/" + hash[:2] + "/" + hash
+}
+
+// acquireBlob adds a reference to the blob holding the content of s, storing the content in the backend if no file has it yet.
+// If the content was stored, stored is true and the content has to be removed by discardBlob should tx not be committed.
+func acquireBlob(tx *sql.Tx, s *spooledFile) (blob *models.FileBlob, stored bool, err error) {
+	// inserting the blob before storing its content locks it, so concurrent uploads of the same content wait for tx instead of storing it at the same key
+	res, err := tx.Exec("INSERT INTO file_blob (hash, uri, size) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE ref_count = ref_count + 1", s.hash, storage.DefaultURI(blobKey(s.hash)), s.size)
+	if err != nil {
+		return nil, false, err
+	}
+	// MySQL reports 1 affected row for an inserted row and 2 for an updated one
+	n, err := res.RowsAffected()
+	if err != nil {
+		return nil, false, err
+	}
+
+	if n == 1 {
+		if _, err = storage.Put(context.Background(), blobKey(s.hash), s, s.size); err != nil {
+			return nil, false, err
+		}
+		stored = true
+	}
+
+	blob, err = models.FindFileBlob(context.Background(), tx, s.hash)
+	return blob, stored, err
+}
+
+// discardBlob removes content stored by acquireBlob. It has to be called before tx is rolled back, while the blob is still locked, so the content of a concurrent upload isn't removed.
+func discardBlob(s *spooledFile) {
+	uri := storage.DefaultURI(blobKey(s.hash))
+	if err := storage.Delete(context.Background(), uri); err != nil {
+		log.Errorf("Unable to remove content %s: %s", uri, err.Error())
+	}
+}
+
+// UnusedContent is content no file refers to anymore. It has to be passed to RemoveContent once the transaction releasing it is committed.
+type UnusedContent struct {
+	// content of files uploaded before content was deduplicated, which each file owned alone
+	URIs []string
+	// blobs whose content and preview are removed, unless the content was uploaded again meanwhile
+	Blobs models.FileBlobSlice
+}
+
+// HardDeleteFiles removes files for good and releases their content and, for files that weren't deleted yet, the quota they used. Content no other file refers to anymore is returned.
+// Files removed concurrently already are skipped, so their content and quota aren't released twice.
+func HardDeleteFiles(tx *sql.Tx, files models.FileSlice) (*UnusedContent, error) {
+	unused := &UnusedContent{}
+	for _, f := range files {
+		n, err := f.Delete(context.Background(), tx, true)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			continue
+		}
+
+		err = releaseQuota(tx, f)
+		if err != nil {
+			return nil, err
+		}
+
+		if f.Local == 0 {
+			continue
+		}
+		// files uploaded before content was deduplicated own their content alone
+		if !f.Hash.Valid {
+			unused.URIs = append(unused.URIs, f.URI)
+			continue
+		}
+
+		blob, err := releaseBlob(tx, f.Hash.String)
+		if err != nil {
+			return nil, err
+		}
+		if blob != nil {
+			unused.Blobs = append(unused.Blobs, blob)
+		}
+	}
+
+	return unused, nil
+}
+
+// releaseBlob removes a reference to a blob. If it was the last one, the blob is deleted and returned.
+func releaseBlob(tx *sql.Tx, hash string) (*models.FileBlob, error) {
+	blob, err := models.FileBlobs(models.FileBlobWhere.Hash.EQ(hash), qm.For("UPDATE")).One(context.Background(), tx)
+	if err != nil {
+		return nil, err
+	}
+
+	blob.RefCount--
+	if blob.RefCount > 0 {
+		_, err = blob.Update(context.Background(), tx, boil.Whitelist(models.FileBlobColumns.RefCount))
+		return nil, err
+	}
+
+	_, err = blob.Delete(context.Background(), tx)
+	if err != nil {
+		return nil, err
+	}
+	return blob, nil
+}
+
+// RemoveContent removes unused content from the storage backends. The content isn't referred to anymore, so failures are only logged.
+func RemoveContent(db *sql.DB, unused *UnusedContent) {
+	for _, uri := range unused.URIs {
+		removeURI(uri)
+	}
+
+	for _, blob := range unused.Blobs {
+		if err := removeBlob(db, blob); err != nil {
+			log.Errorf("Unable to remove content %s: %s", blob.Hash, err.Error())
+		}
+	}
+}
+
+// removeBlob removes the content and preview of a deleted blob, unless the same content was uploaded again since the blob was deleted
+func removeBlob(db *sql.DB, blob *models.FileBlob) error {
+	tx, err := db.BeginTx(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+
+	// locking the hash, even if there is no blob with it, makes uploads of the same content wait until the content is removed
+	_, err = models.FileBlobs(models.FileBlobWhere.Hash.EQ(blob.Hash), qm.For("UPDATE")).One(context.Background(), tx)
+	if err == nil {
+		log.Debugf("Content %s was uploaded again, keeping it", blob.Hash)
+	} else if errors.Is(err, sql.ErrNoRows) {
+		removeURI(blob.URI)
+		if blob.PreviewURI.Valid {
+			removeURI(blob.PreviewURI.String)
+		}
+		err = nil
+	}
+	if err != nil {
+		if e := tx.Rollback(); e != nil {
+			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func removeURI(uri string) {
+	if err := storage.Delete(context.Background(), uri); err != nil {
+		log.Errorf("Unable to remove content %s: %s", uri, err.Error())
+	}
+}
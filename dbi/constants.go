@@ -11,3 +11,8 @@ const (
 	CourseModeratorRoleId
 	CourseUserRoleId
 )
+
+const (
+	GermanLanguageId int = iota + 1
+	EnglishLanguageId
+)
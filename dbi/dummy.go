@@ -23,7 +23,8 @@ func AddDefaultData(db *sql.DB) error {
 	admin_role := models.Role{ID: AdminRoleId, Name: "admin", DisplayName: "Administrator"}
 	moderator_role := models.Role{ID: ModeratorRoleId, Name: "mod", DisplayName: "Moderator"}
 	user_role := models.Role{ID: UserRoleId, Name: "user", DisplayName: "User"}
-	language := models.Language{ID: 1, Name: "Deutsch"}
+	language := models.Language{ID: GermanLanguageId, Name: "Deutsch"}
+	english := models.Language{ID: EnglishLanguageId, Name: "English"}
 	admin := models.User{ID: 1, Firstname: "Admin", Surname: "Admin", Email: "admin@learningbay24.de", Password: password, RoleID: AdminRoleId, PreferredLanguageID: 9999}
 
 	tx, err := db.BeginTx(context.Background(), nil)
@@ -63,6 +64,14 @@ func AddDefaultData(db *sql.DB) error {
 		return err
 	}
 
+	if err := english.Insert(context.Background(), tx, boil.Infer()); err != nil {
+		if e := tx.Rollback(); e != nil {
+			log.Error("Unable to rollback changes from database, aborting insertion of default data")
+		}
+
+		return err
+	}
+
 	admin.PreferredLanguageID = language.ID
 	if err := admin.Insert(context.Background(), tx, boil.Infer()); err != nil {
 		return err
@@ -2,34 +2,37 @@
 package dbi
 
 import (
-	"bufio"
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"io"
 	"net/url"
-	"os"
-	"path"
-	"path/filepath"
-	"strings"
 
 	"learningbay24.de/backend/config"
 	"learningbay24.de/backend/models"
+	"learningbay24.de/backend/preview"
+	"learningbay24.de/backend/scanner"
 
+	log "github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
-// Save a File to disk, creating a database entry alongside it.
-// The fileName can change, depending on if a file with the same name exists already. If the file is a web link (non local), the fileName will become the name given to the URL.
-// The file represents either a local file or a remote one
+// Save a File to the storage backend, creating a database entry alongside it.
+// The fileName is the displayed name of the file, files with the same name or content can exist side by side. If the file is a web link (non local), the fileName will become the name given to the URL.
+// The file represents either a local file or a remote one. Local files are rejected with ErrFileTypeNotAllowed if their type isn't allowed by the config.
 func SaveFile(db *sql.DB, fileName string, uri string, uploaderID int, isLocal bool, file *io.Reader, fileSize int) (int, error) {
-	filePath := config.Conf.Files.Path
-
 	var id int
 	var err error
 
 	if isLocal {
-		id, err = saveLocalFile(db, filePath, fileName, uploaderID, file, fileSize)
+		*file, err = CheckFileType(fileName, *file, config.Conf.Files.AllowedFileTypes)
+		if err != nil {
+			return 0, err
+		}
+
+		id, err = saveLocalFile(db, fileName, uploaderID, file)
 		if err != nil {
 			return 0, err
 		}
@@ -49,54 +52,41 @@ func SaveFile(db *sql.DB, fileName string, uri string, uploaderID int, isLocal b
 	return id, nil
 }
 
-// Save a file locally by creating a new one, never overwriting an old one. Should a file with the exact same name exist already, append the new one with a suffix of "-<count>"
-func saveLocalFile(db *sql.DB, filePath string, fileName string, uploaderID int, file *io.Reader, fileSize int) (int, error) {
-	// possibly changed name due to a file with the same name already existing
-	name := fileName
-
-	// check if file type is valid
-	for num := 0; ; num++ {
-		if _, err := os.Stat(filepath.Join(filePath, name)); err != nil {
-			if !os.IsNotExist(err) {
-				return 0, err
-			} else {
-				break
-			}
-		} else {
-			if num != 0 {
-				ext := path.Ext(name)
-				f := strings.TrimSuffix(name, ext)
-				name = fmt.Sprintf("%s-%d%s", f, num, ext)
-			}
-		}
-	}
-
-	tx, err := db.BeginTx(context.Background(), nil)
+// Save a file to the storage backend. The content is hashed while being received, files with identical content share it instead of storing it again.
+// Files the scanner finds malware in are rejected with scanner.ErrInfected. Previews of images and PDFs are generated in the background afterwards.
+func saveLocalFile(db *sql.DB, fileName string, uploaderID int, file *io.Reader) (int, error) {
+	content, err := spool(*file)
 	if err != nil {
 		return 0, err
 	}
+	defer content.Close()
 
-	// verify user exists and whether the user reached the upload cap yet
-	user, err := models.FindUser(context.Background(), tx, uploaderID)
-	if err != nil {
-		if e := tx.Rollback(); e != nil {
-			return 0, fmt.Errorf("unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
-		}
-
+	// scan before storing anything, so infected content never reaches a storage backend
+	err = scanner.Scan(context.Background(), content)
+	if errors.Is(err, scanner.ErrInfected) {
+		log.Warnf("Rejected infected file \"%s\" uploaded by user %d: %s", fileName, uploaderID, err.Error())
+		return 0, err
+	} else if err != nil {
+		return 0, fmt.Errorf("unable to scan file: %w", err)
+	}
+	if _, err = content.Seek(0, io.SeekStart); err != nil {
 		return 0, err
 	}
 
-	if config.Conf.Files.MaxUploadPerUser != 0 && user.UploadedBytes+fileSize > config.Conf.Files.MaxUploadPerUser {
-		if e := tx.Rollback(); e != nil {
-			return 0, fmt.Errorf("unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
-		}
+	head := make([]byte, 512)
+	n, err := content.ReadAt(head, 0)
+	if err != nil && err != io.EOF {
+		return 0, err
+	}
+	mimeType := mimeType(fileName, head[:n])
 
-		return 0, fmt.Errorf("user has reached the upload limit of %d bytes", config.Conf.Files.MaxUploadPerUser)
+	tx, err := db.BeginTx(context.Background(), nil)
+	if err != nil {
+		return 0, err
 	}
 
-	fullFile := filepath.Join(filePath, name)
-	f := models.File{Name: name, URI: fullFile, Local: 1, UploaderID: uploaderID}
-	err = f.Insert(context.Background(), tx, boil.Infer())
+	// verify user exists and whether the upload fits into the user's quota
+	err = chargeQuota(tx, uploaderID, content.size)
 	if err != nil {
 		if e := tx.Rollback(); e != nil {
 			return 0, fmt.Errorf("unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
@@ -105,20 +95,24 @@ func saveLocalFile(db *sql.DB, filePath string, fileName string, uploaderID int,
 		return 0, err
 	}
 
-	// create file on disk
-	fp, err := os.Create(fullFile)
+	blob, stored, err := acquireBlob(tx, content)
 	if err != nil {
+		if stored {
+			discardBlob(content)
+		}
 		if e := tx.Rollback(); e != nil {
 			return 0, fmt.Errorf("unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
 		}
 
 		return 0, err
 	}
-	defer fp.Close()
-	// write to the file on disk
-	bufr := bufio.NewReader(*file)
-	_, err = bufr.WriteTo(fp)
+
+	f := models.File{Name: fileName, URI: blob.URI, Local: 1, UploaderID: uploaderID, Hash: null.StringFrom(blob.Hash), Size: content.size, MimeType: null.StringFrom(mimeType)}
+	err = f.Insert(context.Background(), tx, boil.Infer())
 	if err != nil {
+		if stored {
+			discardBlob(content)
+		}
 		if e := tx.Rollback(); e != nil {
 			return 0, fmt.Errorf("unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
 		}
@@ -128,6 +122,7 @@ func saveLocalFile(db *sql.DB, filePath string, fileName string, uploaderID int,
 
 	err = tx.Commit()
 	if err != nil {
+		// stored content is kept, as the commit may have succeeded anyway and the blob isn't locked anymore
 		if e := tx.Rollback(); e != nil {
 			return 0, fmt.Errorf("unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
 		}
@@ -135,6 +130,10 @@ func saveLocalFile(db *sql.DB, filePath string, fileName string, uploaderID int,
 		return 0, err
 	}
 
+	if !blob.PreviewURI.Valid {
+		preview.Enqueue(blob.Hash, mimeType)
+	}
+
 	return f.ID, nil
 }
 
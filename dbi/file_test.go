@@ -0,0 +1,38 @@
+package dbi
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"learningbay24.de/backend/scanner"
+)
+
+func TestSaveFileRejectsInfected(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+
+	fake := scanner.NewFake()
+	scanner.Set(fake)
+	defer scanner.Set(scanner.Nop{})
+
+	var file io.Reader = strings.NewReader(scanner.Eicar)
+	_, err = SaveFile(db, "notes.txt", "", 1, true, &file, len(scanner.Eicar))
+	assert.True(t, errors.Is(err, scanner.ErrInfected))
+	assert.Equal(t, 1, fake.Scanned)
+
+	// a daemon that can't be reached rejects the file as well
+	fake.Err = errors.New("connection refused")
+	file = strings.NewReader("lecture notes")
+	_, err = SaveFile(db, "notes.txt", "", 1, true, &file, 13)
+	assert.Error(t, err)
+
+	// the file was rejected before the transaction saving it began
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,163 @@
+package dbi
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"learningbay24.de/backend/config"
+)
+
+// ErrFileTypeNotAllowed is returned when an uploaded file has an extension that isn't allowed or content that doesn't match its extension
+var ErrFileTypeNotAllowed = errors.New("file type not allowed")
+
+// ErrFileTooLarge is returned when an uploaded file exceeds the size allowed for it
+var ErrFileTooLarge = errors.New("file too large")
+
+// FileTooLarge returns an ErrFileTooLarge that tells the maximum size of a file
+func FileTooLarge(maxBytes int64) error {
+	return fmt.Errorf("%w: files can be at most %d MB", ErrFileTooLarge, maxBytes>>20)
+}
+
+// the types http.DetectContentType reports for the content of files with these extensions.
+// Extensions missing here can't be detected reliably and are only checked for not being executables.
+var contentTypes = map[string][]string{
+	"pdf":  {"application/pdf"},
+	"png":  {"image/png"},
+	"jpg":  {"image/jpeg"},
+	"jpeg": {"image/jpeg"},
+	"gif":  {"image/gif"},
+	"webp": {"image/webp"},
+	"bmp":  {"image/bmp"},
+	"zip":  {"application/zip"},
+	"gz":   {"application/x-gzip"},
+	"rar":  {"application/x-rar-compressed"},
+	"txt":  {"text/plain"},
+	"csv":  {"text/plain"},
+	"md":   {"text/plain"},
+	"mp3":  {"audio/mpeg"},
+	"mp4":  {"video/mp4"},
+	// office documents are zip archives
+	"docx": {"application/zip"},
+	"xlsx": {"application/zip"},
+	"pptx": {"application/zip"},
+	"odt":  {"application/zip"},
+	"ods":  {"application/zip"},
+	"odp":  {"application/zip"},
+}
+
+// magic numbers of executables, which are never allowed regardless of their extension
+var executableSignatures = [][]byte{
+	[]byte("\x7fELF"),          // Linux
+	[]byte("\xfe\xed\xfa\xce"), // macOS, 32 bit
+	[]byte("\xfe\xed\xfa\xcf"), // macOS, 64 bit
+	[]byte("\xce\xfa\xed\xfe"),
+	[]byte("\xcf\xfa\xed\xfe"),
+	[]byte("\xca\xfe\xba\xbe"), // macOS universal binaries
+}
+
+// magic numbers of executables made of printable characters, which text files can start with as well.
+// They are only checked if the content isn't text.
+var textExecutableSignatures = [][]byte{
+	[]byte("MZ"), // Windows, the header following it contains binary data
+	[]byte("#!"), // scripts
+}
+
+// FileType returns the extension of a file name in lower case and without the leading dot
+func FileType(fileName string) string {
+	return strings.ToLower(strings.TrimPrefix(path.Ext(fileName), "."))
+}
+
+// CheckFileType takes the name and content of a file and a list of allowed extensions and checks whether the file's extension is allowed and its content matches the extension.
+// An empty list allows every extension. The beginning of the content has to be read to detect its type, so the returned reader has to be used instead of file afterwards.
+func CheckFileType(fileName string, file io.Reader, allowed []string) (io.Reader, error) {
+	if err := CheckFileExtension(fileName, allowed); err != nil {
+		return file, err
+	}
+	ext := FileType(fileName)
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return file, err
+	}
+	head = head[:n]
+	file = io.MultiReader(bytes.NewReader(head), file)
+
+	// empty files can't be detected
+	if n == 0 {
+		return file, nil
+	}
+
+	detected := http.DetectContentType(head)
+	if hasSignature(head, executableSignatures) || (!strings.HasPrefix(detected, "text/") && hasSignature(head, textExecutableSignatures)) {
+		return file, fmt.Errorf("%w: \"%s\" is an executable", ErrFileTypeNotAllowed, fileName)
+	}
+
+	expected, ok := contentTypes[ext]
+	if !ok {
+		return file, nil
+	}
+
+	for _, e := range expected {
+		if strings.HasPrefix(detected, e) {
+			return file, nil
+		}
+	}
+
+	return file, fmt.Errorf("%w: the content of \"%s\" doesn't match its extension", ErrFileTypeNotAllowed, fileName)
+}
+
+func hasSignature(head []byte, signatures [][]byte) bool {
+	for _, sig := range signatures {
+		if bytes.HasPrefix(head, sig) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckFileExtension takes the name of a file and a list of allowed extensions and checks whether the file's extension is allowed, before its content is known.
+// An empty list allows every extension.
+func CheckFileExtension(fileName string, allowed []string) error {
+	ext := FileType(fileName)
+	if len(allowed) != 0 && !containsFileType(allowed, ext) {
+		return fmt.Errorf("%w: files of type \"%s\" can't be uploaded, allowed are: %s", ErrFileTypeNotAllowed, ext, strings.Join(allowed, ", "))
+	}
+	return nil
+}
+
+// NormalizeFileTypes takes a list of file extensions, converts them to the format used by AllowedFileTypes and checks that all of them are allowed globally
+func NormalizeFileTypes(types []string) ([]string, error) {
+	var normalized []string
+	for _, t := range types {
+		t = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(t), "."))
+		if t == "" || containsFileType(normalized, t) {
+			continue
+		}
+		if strings.Contains(t, ",") {
+			return nil, fmt.Errorf("invalid file type \"%s\"", t)
+		}
+
+		allowed := config.Conf.Files.AllowedFileTypes
+		if len(allowed) != 0 && !containsFileType(allowed, t) {
+			return nil, fmt.Errorf("%w: files of type \"%s\" aren't allowed on this site", ErrFileTypeNotAllowed, t)
+		}
+		normalized = append(normalized, t)
+	}
+
+	return normalized, nil
+}
+
+func containsFileType(types []string, t string) bool {
+	for _, a := range types {
+		if strings.EqualFold(strings.TrimPrefix(a, "."), t) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,28 @@
+package dbi
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckFileTypeExecutables(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		rejected bool
+	}{
+		{"names.txt", "MZ Müller, Anna\nSchmidt, Ben\n", false},
+		{"snippet.txt", "#!/bin/sh\necho hello\n", false},
+		{"notes.md", "MZ", false},
+		{"setup.txt", "MZ\x90\x00\x03\x00\x00\x00\x04\x00\x00\x00\xff\xff\x00\x00", true},
+		{"run.txt", "\x7fELF\x02\x01\x01\x00", true},
+	}
+
+	for _, tt := range tests {
+		_, err := CheckFileType(tt.name, strings.NewReader(tt.content), nil)
+		assert.Equal(t, tt.rejected, errors.Is(err, ErrFileTypeNotAllowed), tt.name)
+	}
+}
@@ -0,0 +1,69 @@
+package dbi
+
+import (
+	"context"
+	"database/sql"
+	"mime"
+	"net/http"
+
+	"learningbay24.de/backend/models"
+)
+
+// DefaultMimeType is reported for files whose type is unknown
+const DefaultMimeType = "application/octet-stream"
+
+// mimeType takes the name of a file and the beginning of its content and returns its MIME type.
+// The extension is preferred, as CheckFileType made sure the content matches it, the content is sniffed otherwise.
+func mimeType(fileName string, head []byte) string {
+	if t := mime.TypeByExtension("." + FileType(fileName)); t != "" {
+		return t
+	}
+	return http.DetectContentType(head)
+}
+
+// GetPreview takes a file and returns the URI of the preview of its content, or sql.ErrNoRows if it has none
+func GetPreview(db *sql.DB, f *models.File) (string, error) {
+	if f.Local == 0 || !f.Hash.Valid {
+		return "", sql.ErrNoRows
+	}
+
+	blob, err := models.FindFileBlob(context.Background(), db, f.Hash.String)
+	if err != nil {
+		return "", err
+	}
+	if !blob.PreviewURI.Valid {
+		return "", sql.ErrNoRows
+	}
+	return blob.PreviewURI.String, nil
+}
+
+// HasPreview takes a list of files and returns the IDs of those that have a preview
+func HasPreview(db *sql.DB, files []*models.File) (map[int]bool, error) {
+	var hashes []string
+	for _, f := range files {
+		if f.Local == 1 && f.Hash.Valid {
+			hashes = append(hashes, f.Hash.String)
+		}
+	}
+
+	previews := make(map[int]bool)
+	if len(hashes) == 0 {
+		return previews, nil
+	}
+
+	blobs, err := models.FileBlobs(models.FileBlobWhere.Hash.IN(hashes), models.FileBlobWhere.PreviewURI.IsNotNull()).All(context.Background(), db)
+	if err != nil {
+		return nil, err
+	}
+
+	withPreview := make(map[string]bool, len(blobs))
+	for _, b := range blobs {
+		withPreview[b.Hash] = true
+	}
+	for _, f := range files {
+		if f.Hash.Valid && withPreview[f.Hash.String] {
+			previews[f.ID] = true
+		}
+	}
+	return previews, nil
+}
@@ -0,0 +1,241 @@
+package dbi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"learningbay24.de/backend/config"
+	"learningbay24.de/backend/models"
+	"learningbay24.de/backend/storage"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// ErrQuotaExceeded is returned when an upload would exceed the upload quota of its uploader
+var ErrQuotaExceeded = errors.New("upload quota exceeded")
+
+// Quota is the number of bytes a user uploaded in local files and the number of bytes the user may upload in total. A Limit of 0 means unlimited.
+type Quota struct {
+	Used  int64 `json:"used"`
+	Limit int64 `json:"limit"`
+}
+
+// uploadLimit returns the number of bytes a user may upload in total, which is set for the user, else for the user's role, else in the config
+func uploadLimit(exec boil.ContextExecutor, user *models.User) (int64, error) {
+	if user.UploadQuota.Valid {
+		return user.UploadQuota.Int64, nil
+	}
+
+	role, err := models.FindRole(context.Background(), exec, user.RoleID)
+	if err != nil {
+		return 0, err
+	}
+	if role.UploadQuota.Valid {
+		return role.UploadQuota.Int64, nil
+	}
+
+	return int64(config.Conf.Files.MaxUploadPerUser), nil
+}
+
+// chargeQuota takes the ID of a user and the size of an upload and adds it to the bytes uploaded by the user, unless the user's quota would be exceeded
+func chargeQuota(tx *sql.Tx, userId int, size int64) error {
+	// lock the user, so concurrent uploads can't exceed the quota together
+	user, err := models.Users(models.UserWhere.ID.EQ(userId), qm.For("UPDATE")).One(context.Background(), tx)
+	if err != nil {
+		return err
+	}
+
+	limit, err := uploadLimit(tx, user)
+	if err != nil {
+		return err
+	}
+	if limit != 0 && user.UploadedBytes+size > limit {
+		return quotaExceeded(user.UploadedBytes, limit)
+	}
+
+	user.UploadedBytes += size
+	_, err = user.Update(context.Background(), tx, boil.Whitelist(models.UserColumns.UploadedBytes))
+	return err
+}
+
+// CheckQuota takes the ID of a user and the size of an upload and returns ErrQuotaExceeded if the upload wouldn't fit into the user's quota.
+// It allows rejecting uploads before receiving them, the quota is only charged once the file is saved.
+func CheckQuota(db *sql.DB, userId int, size int64) error {
+	quota, err := GetQuota(db, userId)
+	if err != nil {
+		return err
+	}
+	if quota.Limit != 0 && quota.Used+size > quota.Limit {
+		return quotaExceeded(quota.Used, quota.Limit)
+	}
+
+	return nil
+}
+
+func quotaExceeded(used, limit int64) error {
+	return fmt.Errorf("%w: %d of %d bytes are used already", ErrQuotaExceeded, used, limit)
+}
+
+// releaseQuota gives the size of a local file, that isn't deleted yet, back to the quota of its uploader
+func releaseQuota(tx *sql.Tx, f *models.File) error {
+	if f.Local == 0 || f.DeletedAt.Valid || f.Size == 0 {
+		return nil
+	}
+
+	_, err := tx.Exec("UPDATE user SET uploaded_bytes = GREATEST(uploaded_bytes - ?, 0) WHERE id = ?", f.Size, f.UploaderID)
+	return err
+}
+
+// DeleteFile takes a file and deactivates it within tx, giving its size back to the quota of its uploader.
+// A file deactivated concurrently is left alone, so its size is only given back once.
+func DeleteFile(tx *sql.Tx, f *models.File) error {
+	now := time.Now()
+	res, err := tx.Exec("UPDATE file SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL", now, f.ID)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return nil
+	}
+
+	if err = releaseQuota(tx, f); err != nil {
+		return err
+	}
+	f.DeletedAt = null.TimeFrom(now)
+	return nil
+}
+
+// GetQuota takes the ID of a user and returns the user's upload quota
+func GetQuota(db *sql.DB, userId int) (*Quota, error) {
+	user, err := models.FindUser(context.Background(), db, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := uploadLimit(db, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Quota{Used: user.UploadedBytes, Limit: limit}, nil
+}
+
+// SetUserQuota takes the ID of a user and the number of bytes the user may upload, overriding the quota of the user's role. An invalid quota removes the override.
+func SetUserQuota(db *sql.DB, userId int, quota null.Int64) error {
+	if quota.Valid && quota.Int64 < 0 {
+		return fmt.Errorf("invalid quota %d", quota.Int64)
+	}
+
+	user, err := models.FindUser(context.Background(), db, userId)
+	if err != nil {
+		return err
+	}
+
+	user.UploadQuota = quota
+	_, err = user.Update(context.Background(), db, boil.Whitelist(models.UserColumns.UploadQuota))
+	return err
+}
+
+// SetRoleQuota takes the ID of a role and the number of bytes users with the role may upload, overriding MaxUploadPerUser. An invalid quota removes the override.
+func SetRoleQuota(db *sql.DB, roleId int, quota null.Int64) error {
+	if quota.Valid && quota.Int64 < 0 {
+		return fmt.Errorf("invalid quota %d", quota.Int64)
+	}
+
+	role, err := models.FindRole(context.Background(), db, roleId)
+	if err != nil {
+		return err
+	}
+
+	role.UploadQuota = quota
+	_, err = role.Update(context.Background(), db, boil.Whitelist(models.RoleColumns.UploadQuota))
+	return err
+}
+
+// RepairUploadedBytes reads the size of every local file from its stored content and recomputes the number of bytes each user uploaded from them.
+// It returns the number of users whose count was wrong. Uploads happening meanwhile can be miscounted, so it should be run while the site is idle.
+func RepairUploadedBytes(db *sql.DB) (int, error) {
+	files, err := models.Files(models.FileWhere.Local.EQ(1), qm.WithDeleted()).All(context.Background(), db)
+	if err != nil {
+		return 0, err
+	}
+
+	// sizes are read before starting the transaction, as reading them from a remote backend can take a while
+	sizes := make(map[int]int64, len(files))
+	for _, f := range files {
+		info, err := storage.Stat(context.Background(), f.URI)
+		if errors.Is(err, storage.ErrNotExist) {
+			log.Warnf("Content of file %d is missing, counting it as empty", f.ID)
+			sizes[f.ID] = 0
+			continue
+		} else if err != nil {
+			return 0, err
+		}
+		sizes[f.ID] = info.Size
+	}
+
+	tx, err := db.BeginTx(context.Background(), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	uploaded := make(map[int]int64)
+	for _, f := range files {
+		if f.Size != sizes[f.ID] {
+			f.Size = sizes[f.ID]
+			_, err = f.Update(context.Background(), tx, boil.Whitelist(models.FileColumns.Size))
+			if err != nil {
+				if e := tx.Rollback(); e != nil {
+					return 0, fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+				}
+				return 0, err
+			}
+		}
+
+		if !f.DeletedAt.Valid {
+			uploaded[f.UploaderID] += f.Size
+		}
+	}
+
+	users, err := models.Users().All(context.Background(), tx)
+	if err != nil {
+		if e := tx.Rollback(); e != nil {
+			return 0, fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+		}
+		return 0, err
+	}
+
+	repaired := 0
+	for _, u := range users {
+		if u.UploadedBytes == uploaded[u.ID] {
+			continue
+		}
+
+		log.Infof("Correcting uploaded bytes of user %d from %d to %d", u.ID, u.UploadedBytes, uploaded[u.ID])
+		u.UploadedBytes = uploaded[u.ID]
+		_, err = u.Update(context.Background(), tx, boil.Whitelist(models.UserColumns.UploadedBytes))
+		if err != nil {
+			if e := tx.Rollback(); e != nil {
+				return 0, fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+			}
+			return 0, err
+		}
+		repaired++
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+	return repaired, nil
+}
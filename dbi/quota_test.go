@@ -0,0 +1,36 @@
+package dbi
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"learningbay24.de/backend/models"
+)
+
+func TestDeleteFileReleasesQuotaOnce(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+
+	f := &models.File{ID: 1, Local: 1, UploaderID: 2, Size: 10}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE file SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL")).WithArgs(sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE user SET uploaded_bytes")).WithArgs(int64(10), 2).WillReturnResult(sqlmock.NewResult(0, 1))
+	// the file was deleted concurrently meanwhile, so its size isn't given back again
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE file SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL")).WithArgs(sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NoError(t, DeleteFile(tx, f))
+	assert.True(t, f.DeletedAt.Valid)
+
+	assert.NoError(t, DeleteFile(tx, &models.File{ID: 1, Local: 1, UploaderID: 2, Size: 10}))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"learningbay24.de/backend/models"
+	"learningbay24.de/backend/session"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/volatiletech/sqlboiler/v4/boil"
@@ -69,6 +71,17 @@ func VerifyCredentials(db *sql.DB, email string, password []byte) (int, error) {
 	return user.ID, nil
 }
 
+// SetPassword takes the ID of a user and a new cleartext password and stores its hash within exec
+func SetPassword(exec boil.ContextExecutor, userId int, password []byte) error {
+	hash, err := bcrypt.GenerateFromPassword(password, bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	_, err = exec.ExecContext(context.Background(), "UPDATE user SET password = ?, updated_at = ? WHERE id = ?", hash, time.Now(), userId)
+	return err
+}
+
 // Recursively delete a user with their id.
 // This doesn't delete forum entries, certificates or exams.
 func DeleteUser(db *sql.DB, id int) error {
@@ -124,6 +137,16 @@ func DeleteUser(db *sql.DB, id int) error {
 	}
 	flog.Infof("Deleted %d entries from user_has_course", uhc)
 
+	// user_has_field_of_study has no soft-delete, so the user is removed from all fields of study
+	err = (&models.User{ID: id}).SetFieldOfStudies(context.Background(), tx, false)
+	if err != nil {
+		flog.Errorf("Unable to delete user_has_field_of_study: %s", err.Error())
+		if e := tx.Rollback(); e != nil {
+			return fmt.Errorf("unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+		}
+		return err
+	}
+
 	user, err := models.Users(models.UserWhere.ID.EQ(id)).DeleteAll(context.Background(), tx, false)
 	if err != nil {
 		flog.Errorf("Unable to delete user with id %d: %s", id, err.Error())
@@ -134,7 +157,16 @@ func DeleteUser(db *sql.DB, id int) error {
 	}
 	flog.Infof("Deleted %d entries from user", user)
 
-	// TODO: user_has_field_of_study?
+	// log the user out everywhere, access tokens issued already stop working as well
+	sessions, err := session.RevokeAll(tx, id)
+	if err != nil {
+		flog.Errorf("Unable to revoke sessions: %s", err.Error())
+		if e := tx.Rollback(); e != nil {
+			return fmt.Errorf("unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+		}
+		return err
+	}
+	flog.Infof("Revoked %d sessions", sessions)
 
 	if err := tx.Commit(); err != nil {
 		return fmt.Errorf("unable to commit transaction: %s", err)
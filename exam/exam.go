@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"time"
 
+	"learningbay24.de/backend/certificate"
 	"learningbay24.de/backend/course"
 
 	"github.com/volatiletech/null/v8"
@@ -17,6 +18,9 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"learningbay24.de/backend/dbi"
 	"learningbay24.de/backend/models"
+	"learningbay24.de/backend/notification"
+
+	log "github.com/sirupsen/logrus"
 )
 
 type ExamService interface {
@@ -243,7 +247,7 @@ func (p *PublicController) UploadExamFile(fileName string, uri string, uploaderI
 	if uploaderId == ex.CreatorID {
 		fileId, err := dbi.SaveFile(p.Database, fileName, uri, uploaderId, local, &file, fileSize)
 		if err != nil {
-			return fmt.Errorf("error at saving file: %s", err)
+			return fmt.Errorf("error at saving file: %w", err)
 		}
 		tx, err := p.Database.BeginTx(context.Background(), nil)
 		if err != nil {
@@ -285,13 +289,13 @@ func (p *PublicController) UploadExamFile(fileName string, uri string, uploaderI
 // DeleteExamFile takes a transaction and examId and deletes the file associated to the exam
 func (p *PublicController) DeleteExamFile(tx *sql.Tx, examId int) error {
 	var files []*models.File
-	err := queries.Raw("select * from file, exam_has_files where exam_has_files.exam_id=? AND exam_has_files.file_id = file.id AND file.id is null", examId).Bind(context.Background(), p.Database, &files)
+	err := queries.Raw("select * from file, exam_has_files where exam_has_files.exam_id=? AND exam_has_files.file_id = file.id AND file.deleted_at is null", examId).Bind(context.Background(), p.Database, &files)
 	if err != nil {
 		return err
 	}
 
 	if len(files) != 0 {
-		_, err = files[0].Delete(context.Background(), tx, false)
+		err = dbi.DeleteFile(tx, files[0])
 		if err != nil {
 			return err
 		}
@@ -512,6 +516,7 @@ func (p *PublicController) GetAnswerFromAttendee(userId, examId int) (*models.Fi
 }
 
 // GradeAnswer takes an examId, creatorId, userId, grade, passed-indicator, and feedback and grades the associated answer
+// If the answer passed, a certificate is issued to the user
 // If every answer of an exam has a grade it sets itself to graded
 func (p *PublicController) GradeAnswer(examId, creatorId, userId int, grade null.Int, passed null.Int8, feedback null.String) error {
 	ex, err := models.FindExam(context.Background(), p.Database, examId)
@@ -540,6 +545,20 @@ func (p *PublicController) GradeAnswer(examId, creatorId, userId int, grade null
 		return err
 	}
 
+	// passing an exam earns a certificate for its course
+	if passed.Valid && passed.Int8 == 1 {
+		_, err = certificate.IssueForExam(tx, userId, examId)
+	} else {
+		err = certificate.RevokeForExam(tx, userId, examId)
+	}
+	if err != nil {
+		if e := tx.Rollback(); e != nil {
+			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+		}
+
+		return err
+	}
+
 	attendees, err := p.GetRegisteredUsersFromExam(examId, creatorId)
 	if err != nil {
 		if e := tx.Rollback(); e != nil {
@@ -549,27 +568,34 @@ func (p *PublicController) GradeAnswer(examId, creatorId, userId int, grade null
 		return err
 	}
 
+	graded := true
 	for _, att := range attendees {
 		if att.Grade.Int == 1 {
-			if e := tx.Commit(); e != nil {
-				return fmt.Errorf("fatal: unable to commit transaction on error: %s; %s", err, e)
-			}
-			return nil
+			graded = false
+			break
 		}
 	}
 
-	ex.Graded = 1
-	_, err = ex.Update(context.Background(), tx, boil.Infer())
-	if err != nil {
-		if e := tx.Rollback(); e != nil {
-			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
-		}
+	if graded {
+		ex.Graded = 1
+		_, err = ex.Update(context.Background(), tx, boil.Infer())
+		if err != nil {
+			if e := tx.Rollback(); e != nil {
+				return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+			}
 
-		return err
+			return err
+		}
 	}
 	if e := tx.Commit(); e != nil {
 		return fmt.Errorf("fatal: unable to commit transaction on error: %s; %s", err, e)
 	}
+
+	// failing to notify doesn't fail the grading
+	_, err = notification.Notify(p.Database, userId, "Exam graded", fmt.Sprintf("Your answer to \"%s\" has been graded.", ex.Name), "/users/exams/attended")
+	if err != nil {
+		log.Errorf("Unable to notify about graded exam: %s", err.Error())
+	}
 	return nil
 
 }
@@ -0,0 +1,209 @@
+package fieldofstudy
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"learningbay24.de/backend/models"
+)
+
+// Semester is a single semester of the curriculum of a field of study
+type Semester struct {
+	Semester int                `json:"semester"`
+	Courses  models.CourseSlice `json:"courses"`
+}
+
+// GetFieldsOfStudy returns all fields of study ordered by name
+func GetFieldsOfStudy(db *sql.DB) (models.FieldOfStudySlice, error) {
+	fields, err := models.FieldOfStudies(qm.OrderBy(models.FieldOfStudyColumns.Name+" ASC")).All(context.Background(), db)
+	if err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// GetFieldOfStudy takes the ID of a field of study and returns it
+func GetFieldOfStudy(db *sql.DB, id int) (*models.FieldOfStudy, error) {
+	fos, err := models.FindFieldOfStudy(context.Background(), db, id)
+	if err != nil {
+		return nil, err
+	}
+	return fos, nil
+}
+
+// CreateFieldOfStudy takes a name and the number of semesters and creates a field of study with an empty curriculum
+func CreateFieldOfStudy(db *sql.DB, name string, semesters int) (int, error) {
+	if strings.TrimSpace(name) == "" {
+		return 0, errors.New("name can't be empty")
+	}
+	if semesters < 1 {
+		return 0, errors.New("a field of study needs at least one semester")
+	}
+
+	fos := &models.FieldOfStudy{Name: null.StringFrom(name), Semesters: null.IntFrom(semesters)}
+	err := fos.Insert(context.Background(), db, boil.Infer())
+	if err != nil {
+		return 0, err
+	}
+	return fos.ID, nil
+}
+
+// EditFieldOfStudy takes the ID of a field of study, a name and the number of semesters and overwrites them.
+// An empty name or invalid number of semesters keeps the old value. The number of semesters can't be reduced below a semester that still has courses assigned.
+func EditFieldOfStudy(db *sql.DB, id int, name string, semesters null.Int) (*models.FieldOfStudy, error) {
+	fos, err := models.FindFieldOfStudy(context.Background(), db, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(name) != "" {
+		fos.Name = null.StringFrom(name)
+	}
+	if semesters.Valid {
+		if semesters.Int < 1 {
+			return nil, errors.New("a field of study needs at least one semester")
+		}
+
+		assigned, err := fos.FieldOfStudyHasCourses(models.FieldOfStudyHasCourseWhere.Semester.GT(semesters.Int)).Exists(context.Background(), db)
+		if err != nil {
+			return nil, err
+		}
+		if assigned {
+			return nil, fmt.Errorf("there are still courses assigned to semesters after semester %d", semesters.Int)
+		}
+
+		fos.Semesters = semesters
+	}
+
+	_, err = fos.Update(context.Background(), db, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return fos, nil
+}
+
+// DeleteFieldOfStudy takes the ID of a field of study and soft-deletes it
+func DeleteFieldOfStudy(db *sql.DB, id int) error {
+	fos, err := models.FindFieldOfStudy(context.Background(), db, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = fos.Delete(context.Background(), db, false)
+	return err
+}
+
+// GetCurriculum takes the ID of a field of study and returns its courses grouped by the semester they are supposed to be taken in.
+// Every semester of the field of study is returned, even if it has no courses assigned.
+func GetCurriculum(db *sql.DB, id int) ([]Semester, error) {
+	fos, err := models.FindFieldOfStudy(context.Background(), db, id)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := fos.FieldOfStudyHasCourses(qm.Load(models.FieldOfStudyHasCourseRels.Course)).All(context.Background(), db)
+	if err != nil {
+		return nil, err
+	}
+
+	semesters := make([]Semester, fos.Semesters.Int)
+	for i := range semesters {
+		semesters[i] = Semester{Semester: i + 1, Courses: models.CourseSlice{}}
+	}
+	for _, e := range entries {
+		// soft-deleted courses aren't loaded
+		if e.R == nil || e.R.Course == nil || e.Semester < 1 || e.Semester > len(semesters) {
+			continue
+		}
+		semesters[e.Semester-1].Courses = append(semesters[e.Semester-1].Courses, e.R.Course)
+	}
+	for _, s := range semesters {
+		sort.Slice(s.Courses, func(i, j int) bool { return s.Courses[i].Name < s.Courses[j].Name })
+	}
+
+	return semesters, nil
+}
+
+// SetCourseSemester takes the ID of a field of study, a course and a semester and assigns the course to that semester of the curriculum.
+// If the course is already part of the curriculum, it is moved to the given semester.
+func SetCourseSemester(db *sql.DB, id, courseId, semester int) error {
+	fos, err := models.FindFieldOfStudy(context.Background(), db, id)
+	if err != nil {
+		return err
+	}
+	if semester < 1 || semester > fos.Semesters.Int {
+		return fmt.Errorf("semester has to be between 1 and %d", fos.Semesters.Int)
+	}
+
+	_, err = models.FindCourse(context.Background(), db, courseId)
+	if err != nil {
+		return err
+	}
+
+	fhc, err := models.FindFieldOfStudyHasCourse(context.Background(), db, id, courseId)
+	if errors.Is(err, sql.ErrNoRows) {
+		fhc = &models.FieldOfStudyHasCourse{FieldOfStudyID: id, CourseID: courseId, Semester: semester}
+		return fhc.Insert(context.Background(), db, boil.Infer())
+	} else if err != nil {
+		return err
+	}
+
+	fhc.Semester = semester
+	_, err = fhc.Update(context.Background(), db, boil.Infer())
+	return err
+}
+
+// RemoveCourse takes the ID of a field of study and a course and removes the course from the curriculum
+func RemoveCourse(db *sql.DB, id, courseId int) error {
+	fhc, err := models.FindFieldOfStudyHasCourse(context.Background(), db, id, courseId)
+	if err != nil {
+		return err
+	}
+
+	_, err = fhc.Delete(context.Background(), db)
+	return err
+}
+
+// GetFieldsOfStudyFromUser takes the ID of a user and returns all fields of study they are enrolled in
+func GetFieldsOfStudyFromUser(db *sql.DB, userId int) (models.FieldOfStudySlice, error) {
+	fields, err := (&models.User{ID: userId}).FieldOfStudies(qm.OrderBy(models.FieldOfStudyColumns.Name+" ASC")).All(context.Background(), db)
+	if err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// EnrollUser takes the ID of a user and a field of study and enrolls the user in it
+func EnrollUser(db *sql.DB, userId, id int) error {
+	fos, err := models.FindFieldOfStudy(context.Background(), db, id)
+	if err != nil {
+		return err
+	}
+
+	enrolled, err := fos.Users(models.UserWhere.ID.EQ(userId)).Exists(context.Background(), db)
+	if err != nil {
+		return err
+	}
+	if enrolled {
+		return errors.New("user is already enrolled in this field of study")
+	}
+
+	return fos.AddUsers(context.Background(), db, false, &models.User{ID: userId})
+}
+
+// UnenrollUser takes the ID of a user and a field of study and removes the user from it
+func UnenrollUser(db *sql.DB, userId, id int) error {
+	fos, err := models.FindFieldOfStudy(context.Background(), db, id)
+	if err != nil {
+		return err
+	}
+
+	return fos.RemoveUsers(context.Background(), db, &models.User{ID: userId})
+}
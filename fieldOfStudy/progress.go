@@ -0,0 +1,138 @@
+package fieldofstudy
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"learningbay24.de/backend/models"
+)
+
+// CourseStatus describes how far a user has got with a course of their curriculum
+type CourseStatus string
+
+const (
+	// the user passed an exam of the course or holds a certificate of it
+	StatusCompleted CourseStatus = "completed"
+	// the user is enrolled in the course
+	StatusInProgress CourseStatus = "in_progress"
+	// the user hasn't started the course yet
+	StatusOpen CourseStatus = "open"
+)
+
+// CourseProgress is the status of a single course of the curriculum
+type CourseProgress struct {
+	Course *models.Course `json:"course"`
+	Status CourseStatus   `json:"status"`
+}
+
+// SemesterProgress is the status of all courses of a single semester of the curriculum
+type SemesterProgress struct {
+	Semester  int              `json:"semester"`
+	Courses   []CourseProgress `json:"courses"`
+	Completed int              `json:"completed"`
+	Total     int              `json:"total"`
+}
+
+// Progress is the progress of a user in a single field of study
+type Progress struct {
+	FieldOfStudy *models.FieldOfStudy `json:"field_of_study"`
+	Semesters    []SemesterProgress   `json:"semesters"`
+	Completed    int                  `json:"completed"`
+	Total        int                  `json:"total"`
+	// share of completed courses in percent
+	Percentage float64 `json:"percentage"`
+}
+
+// GetProgress takes the ID of a user and returns their progress in every field of study they are enrolled in
+func GetProgress(db *sql.DB, userId int) ([]Progress, error) {
+	fields, err := GetFieldsOfStudyFromUser(db, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	completed, err := completedCourses(db, userId)
+	if err != nil {
+		return nil, err
+	}
+	enrolled, err := enrolledCourses(db, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := []Progress{}
+	for _, fos := range fields {
+		curriculum, err := GetCurriculum(db, fos.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		p := Progress{FieldOfStudy: fos, Semesters: []SemesterProgress{}}
+		for _, s := range curriculum {
+			sp := SemesterProgress{Semester: s.Semester, Courses: []CourseProgress{}, Total: len(s.Courses)}
+			for _, c := range s.Courses {
+				status := StatusOpen
+				if completed[c.ID] {
+					status = StatusCompleted
+					sp.Completed++
+				} else if enrolled[c.ID] {
+					status = StatusInProgress
+				}
+				sp.Courses = append(sp.Courses, CourseProgress{Course: c, Status: status})
+			}
+
+			p.Semesters = append(p.Semesters, sp)
+			p.Completed += sp.Completed
+			p.Total += sp.Total
+		}
+		if p.Total > 0 {
+			p.Percentage = float64(p.Completed) * 100 / float64(p.Total)
+		}
+
+		progress = append(progress, p)
+	}
+
+	return progress, nil
+}
+
+// completedCourses takes the ID of a user and returns the IDs of all courses they passed an exam of or hold a certificate of
+func completedCourses(db *sql.DB, userId int) (map[int]bool, error) {
+	completed := map[int]bool{}
+
+	exams, err := models.Exams(
+		qm.InnerJoin(models.TableNames.UserHasExam+" on "+models.TableNames.UserHasExam+".exam_id = "+models.TableNames.Exam+".id"),
+		qm.Where(models.TableNames.UserHasExam+".user_id = ?", userId),
+		qm.Where(models.TableNames.UserHasExam+".passed = ?", 1),
+		qm.Where(models.TableNames.UserHasExam+".deleted_at IS NULL"),
+	).All(context.Background(), db)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range exams {
+		completed[e.CourseID] = true
+	}
+
+	certificates, err := models.Certificates(models.CertificateWhere.UserID.EQ(userId)).All(context.Background(), db)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range certificates {
+		completed[c.LinkedCourseID] = true
+	}
+
+	return completed, nil
+}
+
+// enrolledCourses takes the ID of a user and returns the IDs of all courses they are enrolled in
+func enrolledCourses(db *sql.DB, userId int) (map[int]bool, error) {
+	uhc, err := models.UserHasCourses(models.UserHasCourseWhere.UserID.EQ(userId)).All(context.Background(), db)
+	if err != nil {
+		return nil, err
+	}
+
+	enrolled := map[int]bool{}
+	for _, u := range uhc {
+		enrolled[u.CourseID] = true
+	}
+	return enrolled, nil
+}
@@ -0,0 +1,198 @@
+package forum
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"learningbay24.de/backend/models"
+)
+
+// Thread is a top-level forum entry together with all of its replies
+type Thread struct {
+	*models.ForumEntry
+	Replies models.ForumEntrySlice `json:"replies"`
+}
+
+// GetForumIdFromCourse takes the ID of a course and returns the ID of the forum associated with it
+func GetForumIdFromCourse(db *sql.DB, courseId int) (int, error) {
+	c, err := models.FindCourse(context.Background(), db, courseId)
+	if err != nil {
+		return 0, err
+	}
+	return c.ForumID, nil
+}
+
+// GetEntry takes the ID of a forum and an entry and returns the entry, if it belongs to that forum
+func GetEntry(db *sql.DB, forumId, entryId int) (*models.ForumEntry, error) {
+	e, err := models.FindForumEntry(context.Background(), db, entryId)
+	if err != nil {
+		return nil, err
+	}
+	if e.ForumID != forumId {
+		return nil, errors.New("entry doesn't belong to this forum")
+	}
+	return e, nil
+}
+
+// GetThreads takes the ID of a forum and returns all top-level entries of it, pinned threads first and newest first otherwise
+func GetThreads(db *sql.DB, forumId int) (models.ForumEntrySlice, error) {
+	threads, err := models.ForumEntries(
+		models.ForumEntryWhere.ForumID.EQ(forumId),
+		models.ForumEntryWhere.InReplyTo.IsNull(),
+		qm.OrderBy(models.ForumEntryColumns.Pinned+" DESC"),
+		qm.OrderBy(models.ForumEntryColumns.CreatedAt+" DESC"),
+	).All(context.Background(), db)
+	if err != nil {
+		return nil, err
+	}
+	return threads, nil
+}
+
+// GetThread takes the ID of a forum and a top-level entry and returns the entry together with its replies, oldest first
+func GetThread(db *sql.DB, forumId, entryId int) (*Thread, error) {
+	e, err := GetEntry(db, forumId, entryId)
+	if err != nil {
+		return nil, err
+	}
+	if e.InReplyTo.Valid {
+		return nil, errors.New("entry is a reply and not a thread")
+	}
+
+	replies, err := models.ForumEntries(
+		models.ForumEntryWhere.InReplyTo.EQ(null.IntFrom(e.ID)),
+		qm.OrderBy(models.ForumEntryColumns.CreatedAt+" ASC"),
+	).All(context.Background(), db)
+	if err != nil {
+		return nil, err
+	}
+	return &Thread{ForumEntry: e, Replies: replies}, nil
+}
+
+// CreateThread takes the ID of a forum, the author, a subject and the content and creates a new top-level entry in the forum
+func CreateThread(db *sql.DB, forumId, authorId int, subject, content string) (int, error) {
+	if strings.TrimSpace(subject) == "" {
+		return 0, errors.New("subject can't be empty")
+	}
+	if strings.TrimSpace(content) == "" {
+		return 0, errors.New("content can't be empty")
+	}
+
+	e := &models.ForumEntry{Subject: subject, Content: content, AuthorID: authorId, ForumID: forumId}
+	err := e.Insert(context.Background(), db, boil.Infer())
+	if err != nil {
+		return 0, err
+	}
+	return e.ID, nil
+}
+
+// CreateReply takes the ID of a forum, the entry being replied to, the author, a subject and the content and adds a reply to the thread.
+// Replies always refer to the top-level entry of a thread, even when replying to another reply.
+// If no subject is given, the subject of the thread is used. Replying to a locked thread is not possible.
+func CreateReply(db *sql.DB, forumId, entryId, authorId int, subject, content string) (int, error) {
+	if strings.TrimSpace(content) == "" {
+		return 0, errors.New("content can't be empty")
+	}
+
+	parent, err := GetEntry(db, forumId, entryId)
+	if err != nil {
+		return 0, err
+	}
+	if parent.InReplyTo.Valid {
+		parent, err = GetEntry(db, forumId, parent.InReplyTo.Int)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if parent.Locked == 1 {
+		return 0, errors.New("thread is locked")
+	}
+
+	if strings.TrimSpace(subject) == "" {
+		// the subject column can hold at most 64 characters
+		s := []rune("Re: " + parent.Subject)
+		if len(s) > 64 {
+			s = s[:64]
+		}
+		subject = string(s)
+	}
+
+	e := &models.ForumEntry{Subject: subject, Content: content, InReplyTo: null.IntFrom(parent.ID), AuthorID: authorId, ForumID: forumId}
+	err = e.Insert(context.Background(), db, boil.Infer())
+	if err != nil {
+		return 0, err
+	}
+	return e.ID, nil
+}
+
+// EditEntry takes the ID of a forum, an entry, the editing user, a subject and the content and overwrites the entry.
+// Only the author of an entry is allowed to edit it. An empty subject keeps the old one.
+func EditEntry(db *sql.DB, forumId, entryId, userId int, subject, content string) (*models.ForumEntry, error) {
+	if strings.TrimSpace(content) == "" {
+		return nil, errors.New("content can't be empty")
+	}
+
+	e, err := GetEntry(db, forumId, entryId)
+	if err != nil {
+		return nil, err
+	}
+	if e.AuthorID != userId {
+		return nil, errors.New("only the author can edit an entry")
+	}
+
+	if strings.TrimSpace(subject) != "" {
+		e.Subject = subject
+	}
+	e.Content = content
+
+	_, err = e.Update(context.Background(), db, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// DeleteEntry takes the ID of a forum, an entry and the deleting user and soft-deletes the entry.
+// Deleting a top-level entry deletes all of its replies as well. Only the author of an entry is allowed to delete it.
+func DeleteEntry(db *sql.DB, forumId, entryId, userId int) error {
+	entry, err := GetEntry(db, forumId, entryId)
+	if err != nil {
+		return err
+	}
+	if entry.AuthorID != userId {
+		return errors.New("only the author can delete an entry")
+	}
+
+	tx, err := db.BeginTx(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+
+	if !entry.InReplyTo.Valid {
+		_, err = models.ForumEntries(models.ForumEntryWhere.InReplyTo.EQ(null.IntFrom(entry.ID))).DeleteAll(context.Background(), tx, false)
+		if err != nil {
+			if e := tx.Rollback(); e != nil {
+				return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+			}
+			return err
+		}
+	}
+
+	_, err = entry.Delete(context.Background(), tx, false)
+	if err != nil {
+		if e := tx.Rollback(); e != nil {
+			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+		}
+		return err
+	}
+
+	if e := tx.Commit(); e != nil {
+		return fmt.Errorf("fatal: unable to commit transaction on error: %s; %s", err, e)
+	}
+	return nil
+}
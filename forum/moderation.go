@@ -0,0 +1,143 @@
+package forum
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"learningbay24.de/backend/models"
+)
+
+// SetPinned takes the ID of a forum, a top-level entry and the moderator and pins or unpins the thread
+func SetPinned(db *sql.DB, forumId, entryId, moderatorId int, pinned bool) error {
+	e, err := GetEntry(db, forumId, entryId)
+	if err != nil {
+		return err
+	}
+	if e.InReplyTo.Valid {
+		return errors.New("only threads can be pinned")
+	}
+
+	action := models.ForumModerationLogActionUnpin
+	e.Pinned = 0
+	if pinned {
+		action = models.ForumModerationLogActionPin
+		e.Pinned = 1
+	}
+
+	return moderate(db, e, moderatorId, action, null.String{})
+}
+
+// SetLocked takes the ID of a forum, a top-level entry and the moderator and locks or unlocks the thread.
+// Nobody can reply to a locked thread.
+func SetLocked(db *sql.DB, forumId, entryId, moderatorId int, locked bool) error {
+	e, err := GetEntry(db, forumId, entryId)
+	if err != nil {
+		return err
+	}
+	if e.InReplyTo.Valid {
+		return errors.New("only threads can be locked")
+	}
+
+	action := models.ForumModerationLogActionUnlock
+	e.Locked = 0
+	if locked {
+		action = models.ForumModerationLogActionLock
+		e.Locked = 1
+	}
+
+	return moderate(db, e, moderatorId, action, null.String{})
+}
+
+// HideEntry takes the ID of a forum, an entry, the moderator and a reason and hides the entry from other users
+func HideEntry(db *sql.DB, forumId, entryId, moderatorId int, reason string) error {
+	if strings.TrimSpace(reason) == "" {
+		return errors.New("reason can't be empty")
+	}
+
+	e, err := GetEntry(db, forumId, entryId)
+	if err != nil {
+		return err
+	}
+
+	e.HiddenAt = null.TimeFrom(time.Now())
+	e.HiddenByID = null.IntFrom(moderatorId)
+	e.HiddenReason = null.StringFrom(reason)
+
+	return moderate(db, e, moderatorId, models.ForumModerationLogActionHide, null.StringFrom(reason))
+}
+
+// UnhideEntry takes the ID of a forum, an entry and the moderator and makes a hidden entry visible again
+func UnhideEntry(db *sql.DB, forumId, entryId, moderatorId int) error {
+	e, err := GetEntry(db, forumId, entryId)
+	if err != nil {
+		return err
+	}
+	if !e.HiddenAt.Valid {
+		return errors.New("entry is not hidden")
+	}
+
+	e.HiddenAt = null.Time{}
+	e.HiddenByID = null.Int{}
+	e.HiddenReason = null.String{}
+
+	return moderate(db, e, moderatorId, models.ForumModerationLogActionUnhide, null.String{})
+}
+
+// GetModerationLog takes the ID of a forum and returns all moderation actions performed in it, newest first
+func GetModerationLog(db *sql.DB, forumId int) (models.ForumModerationLogSlice, error) {
+	entries, err := models.ForumModerationLogs(
+		models.ForumModerationLogWhere.ForumID.EQ(forumId),
+		qm.OrderBy(models.ForumModerationLogColumns.CreatedAt+" DESC"),
+	).All(context.Background(), db)
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// RedactHidden removes the subject and content of hidden entries, so they can be shown to users which aren't moderators
+func RedactHidden(entries ...*models.ForumEntry) {
+	for _, e := range entries {
+		if e.HiddenAt.Valid {
+			e.Subject = ""
+			e.Content = ""
+		}
+	}
+}
+
+// moderate updates the given entry and records the action in the moderation log of its forum
+func moderate(db *sql.DB, entry *models.ForumEntry, moderatorId int, action models.ForumModerationLogAction, reason null.String) error {
+	tx, err := db.BeginTx(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = entry.Update(context.Background(), tx, boil.Infer())
+	if err != nil {
+		if e := tx.Rollback(); e != nil {
+			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+		}
+		return err
+	}
+
+	l := models.ForumModerationLog{ForumID: entry.ForumID, EntryID: entry.ID, ModeratorID: moderatorId, Action: action, Reason: reason}
+	err = l.Insert(context.Background(), tx, boil.Infer())
+	if err != nil {
+		if e := tx.Rollback(); e != nil {
+			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+		}
+		return err
+	}
+
+	if e := tx.Commit(); e != nil {
+		return fmt.Errorf("fatal: unable to commit transaction on error: %s; %s", err, e)
+	}
+	return nil
+}
@@ -0,0 +1,257 @@
+// Package janitor removes soft-deleted files for good once their retention period has passed
+package janitor
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"learningbay24.de/backend/config"
+	"learningbay24.de/backend/dbi"
+	"learningbay24.de/backend/models"
+	"learningbay24.de/backend/recovery"
+	"learningbay24.de/backend/session"
+	"learningbay24.de/backend/storage"
+	"learningbay24.de/backend/upload"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// tables linking files to what they belong to
+var joinTables = []string{
+	"course_has_files",
+	"directory_has_files",
+	"exam_has_files",
+	"submission_has_files",
+	"user_submission_has_files",
+}
+
+// columns referring to a single file, which are cleared when the file is removed
+var fileColumns = [][2]string{
+	{"user", "profile_picture"},
+	{"user_has_exam", "file_id"},
+}
+
+// Report describes what a run removed, or would have removed on a dry run
+type Report struct {
+	// number of files removed
+	Files int
+	// number of rows linking the removed files to courses, directories, exams and submissions
+	JoinRows int64
+	// number of bytes freed in the storage backends. Content still shared with other files isn't freed.
+	Bytes int64
+}
+
+// Schedule runs the janitor every interval, as configured in conf. Expired resumable uploads, sessions and password reset tokens are removed as well, also on dry runs, which only concern deleted files.
+func Schedule(db *sql.DB, conf config.Janitor) {
+	interval := time.Duration(conf.IntervalHours) * time.Hour
+	retention := time.Duration(conf.RetentionDays) * 24 * time.Hour
+	for {
+		time.Sleep(interval)
+
+		report, err := Run(db, retention, conf.DryRun)
+		if err != nil {
+			log.Errorf("Unable to remove deleted files: %s", err.Error())
+		} else if conf.DryRun {
+			log.Infof("Janitor dry run: %d deleted files with %d links could be removed, reclaiming %d bytes", report.Files, report.JoinRows, report.Bytes)
+		} else {
+			log.Infof("Janitor removed %d deleted files with %d links, reclaiming %d bytes", report.Files, report.JoinRows, report.Bytes)
+		}
+
+		// each cleanup runs even if the ones before failed
+		if n, err := upload.RemoveExpired(db); err != nil {
+			log.Errorf("Unable to remove expired uploads: %s", err.Error())
+		} else {
+			log.Infof("Janitor removed %d expired uploads", n)
+		}
+
+		if n, err := session.RemoveExpired(db); err != nil {
+			log.Errorf("Unable to remove expired sessions: %s", err.Error())
+		} else {
+			log.Infof("Janitor removed %d expired sessions", n)
+		}
+
+		if n, err := recovery.RemoveExpired(db); err != nil {
+			log.Errorf("Unable to remove expired password reset tokens: %s", err.Error())
+		} else {
+			log.Infof("Janitor removed %d expired password reset tokens", n)
+		}
+	}
+}
+
+// Run takes the time files are kept after being deleted and removes all files deleted before that for good, along with everything linking to them.
+// Content no other file shares anymore is removed from the storage backends. On a dry run nothing is changed, only the report of what would be removed is returned.
+func Run(db *sql.DB, retention time.Duration, dryRun bool) (*Report, error) {
+	deadline := time.Now().Add(-retention)
+	if dryRun {
+		return estimate(db, deadline)
+	}
+
+	tx, err := db.BeginTx(context.Background(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	report, unused, err := purge(tx, deadline)
+	if err != nil {
+		if e := tx.Rollback(); e != nil {
+			return nil, fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+		}
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	// the content is still there, so its size can be reported before removing it
+	uris := unused.URIs
+	for _, blob := range unused.Blobs {
+		report.Bytes += blob.Size
+		if blob.PreviewURI.Valid {
+			uris = append(uris, blob.PreviewURI.String)
+		}
+	}
+	for _, uri := range uris {
+		info, err := storage.Stat(context.Background(), uri)
+		if err == nil {
+			report.Bytes += info.Size
+		} else if !errors.Is(err, storage.ErrNotExist) {
+			log.Errorf("Unable to get size of content %s: %s", uri, err.Error())
+		}
+	}
+	dbi.RemoveContent(db, unused)
+
+	return report, nil
+}
+
+// expiredFiles returns the files deleted before deadline
+func expiredFiles(exec boil.ContextExecutor, deadline time.Time, mods ...qm.QueryMod) (models.FileSlice, error) {
+	mods = append([]qm.QueryMod{
+		qm.WithDeleted(),
+		qm.Where(models.FileColumns.DeletedAt+" IS NOT NULL"),
+		qm.And(models.FileColumns.DeletedAt+" < ?", deadline),
+	}, mods...)
+	return models.Files(mods...).All(context.Background(), exec)
+}
+
+// fileIds returns the IDs of files as query arguments and the matching `(?,?,...)` list of placeholders
+func fileIds(files models.FileSlice) ([]interface{}, string) {
+	ids := make([]interface{}, len(files))
+	for i, f := range files {
+		ids[i] = f.ID
+	}
+	return ids, "(" + strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",") + ")"
+}
+
+// purge removes the files deleted before deadline within tx and returns the content no other file refers to anymore
+func purge(tx *sql.Tx, deadline time.Time) (*Report, *dbi.UnusedContent, error) {
+	// locking the files makes concurrent runs wait, after which the files are gone for them
+	files, err := expiredFiles(tx, deadline, qm.For("UPDATE"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report := &Report{Files: len(files)}
+	if len(files) == 0 {
+		return report, &dbi.UnusedContent{}, nil
+	}
+	ids, in := fileIds(files)
+
+	for _, table := range joinTables {
+		res, err := tx.Exec("DELETE FROM "+table+" WHERE file_id IN "+in, ids...)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		n, err := res.RowsAffected()
+		if err != nil {
+			return nil, nil, err
+		}
+		report.JoinRows += n
+	}
+
+	for _, c := range fileColumns {
+		_, err = tx.Exec("UPDATE "+c[0]+" SET "+c[1]+" = NULL WHERE "+c[1]+" IN "+in, ids...)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	unused, err := dbi.HardDeleteFiles(tx, files)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return report, unused, nil
+}
+
+// estimate reports what purge would remove without changing anything
+func estimate(db *sql.DB, deadline time.Time) (*Report, error) {
+	files, err := expiredFiles(db, deadline)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{Files: len(files)}
+	if len(files) == 0 {
+		return report, nil
+	}
+	ids, in := fileIds(files)
+
+	for _, table := range joinTables {
+		var n int64
+		err = db.QueryRow("SELECT COUNT(*) FROM "+table+" WHERE file_id IN "+in, ids...).Scan(&n)
+		if err != nil {
+			return nil, err
+		}
+		report.JoinRows += n
+	}
+
+	// number of references each blob would lose
+	released := make(map[string]int)
+	for _, f := range files {
+		if f.Local == 0 {
+			continue
+		}
+		if f.Hash.Valid {
+			released[f.Hash.String]++
+			continue
+		}
+
+		// files uploaded before content was deduplicated own their content alone
+		info, err := storage.Stat(context.Background(), f.URI)
+		if err == nil {
+			report.Bytes += info.Size
+		} else if !errors.Is(err, storage.ErrNotExist) {
+			return nil, err
+		}
+	}
+
+	for hash, n := range released {
+		blob, err := models.FindFileBlob(context.Background(), db, hash)
+		if err != nil {
+			return nil, err
+		}
+		if blob.RefCount > n {
+			continue
+		}
+		report.Bytes += blob.Size
+
+		if blob.PreviewURI.Valid {
+			info, err := storage.Stat(context.Background(), blob.PreviewURI.String)
+			if err == nil {
+				report.Bytes += info.Size
+			} else if !errors.Is(err, storage.ErrNotExist) {
+				return nil, err
+			}
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,145 @@
+package janitor
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"learningbay24.de/backend/storage"
+)
+
+const hash = "dc67c811c5b66f1e4b094f47d7e287838e6a12304be983b5e091e47f21d0690e"
+
+// setupStorage stores a file uploaded before content was deduplicated in a temporary local backend and returns its path on disk
+func setupStorage(t *testing.T) string {
+	dir := t.TempDir()
+	storage.Register("local", storage.NewLocal(dir))
+
+	p := filepath.Join(dir, "legacy.txt")
+	if err := os.WriteFile(p, []byte("notes"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func fileRows() *sqlmock.Rows {
+	deleted := time.Now().Add(-48 * time.Hour)
+	return sqlmock.NewRows([]string{"id", "uri", "local", "uploader_id", "hash", "size", "deleted_at"}).
+		AddRow(1, "local:legacy.txt", 1, 1, nil, 5, deleted).
+		AddRow(2, "local:blobs/dc/"+hash, 1, 1, hash, 10, deleted)
+}
+
+func TestRunDryRun(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+	legacy := setupStorage(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `file`")).WillReturnRows(fileRows())
+	for _, table := range joinTables {
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM "+table)).WithArgs(1, 2).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	}
+	mock.ExpectQuery(regexp.QuoteMeta("from `file_blob`")).WithArgs(hash).
+		WillReturnRows(sqlmock.NewRows([]string{"hash", "uri", "size", "ref_count"}).AddRow(hash, "local:blobs/dc/"+hash, 10, 1))
+
+	report, err := Run(db, 24*time.Hour, true)
+	assert.NoError(t, err)
+	assert.Equal(t, &Report{Files: 2, JoinRows: int64(len(joinTables)), Bytes: 15}, report)
+
+	// nothing was removed
+	assert.FileExists(t, legacy)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRunDryRunSharedBlob(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+	setupStorage(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `file`")).WillReturnRows(fileRows())
+	for _, table := range joinTables {
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM " + table)).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	}
+	// another file still refers to the blob, so its content isn't freed
+	mock.ExpectQuery(regexp.QuoteMeta("from `file_blob`")).WithArgs(hash).
+		WillReturnRows(sqlmock.NewRows([]string{"hash", "uri", "size", "ref_count"}).AddRow(hash, "local:blobs/dc/"+hash, 10, 2))
+
+	report, err := Run(db, 24*time.Hour, true)
+	assert.NoError(t, err)
+	assert.Equal(t, &Report{Files: 2, Bytes: 5}, report)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRun(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+	legacy := setupStorage(t)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `file`") + ".*" + regexp.QuoteMeta("FOR UPDATE")).WillReturnRows(sqlmock.NewRows([]string{"id", "uri", "local", "uploader_id", "size", "deleted_at"}).
+		AddRow(1, "local:legacy.txt", 1, 1, 5, time.Now().Add(-48*time.Hour)))
+	for _, table := range joinTables {
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM " + table)).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	for _, c := range fileColumns {
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE " + c[0])).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `file` WHERE `id`=?")).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	report, err := Run(db, 24*time.Hour, false)
+	assert.NoError(t, err)
+	assert.Equal(t, &Report{Files: 1, JoinRows: int64(len(joinTables)), Bytes: 5}, report)
+
+	assert.NoFileExists(t, legacy)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRunRemovedConcurrently(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+	dir := t.TempDir()
+	storage.Register("local", storage.NewLocal(dir))
+
+	blob := filepath.Join(dir, "blobs", "dc", hash)
+	if err := os.MkdirAll(filepath.Dir(blob), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(blob, []byte("lecture notes"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `file`") + ".*" + regexp.QuoteMeta("FOR UPDATE")).WillReturnRows(sqlmock.NewRows([]string{"id", "uri", "local", "uploader_id", "hash", "size", "deleted_at"}).
+		AddRow(2, "local:blobs/dc/"+hash, 1, 1, hash, 10, time.Now().Add(-48*time.Hour)))
+	for _, table := range joinTables {
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM " + table)).WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+	for _, c := range fileColumns {
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE " + c[0])).WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+	// another run removed the file meanwhile, so the blob it referred to isn't released again
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `file` WHERE `id`=?")).WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	_, err = Run(db, 24*time.Hour, false)
+	assert.NoError(t, err)
+
+	assert.FileExists(t, blob)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,113 @@
+package mail
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"learningbay24.de/backend/config"
+)
+
+// Message is a plain text mail
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Sender delivers mails
+type Sender interface {
+	Send(m *Message) error
+}
+
+// SMTPSender delivers mails through an SMTP server
+type SMTPSender struct {
+	Host string
+	Port int
+	User string
+	Pass string
+	From string
+}
+
+// NewSMTPSender takes the mail configuration and returns a sender for it.
+// If no host is configured, nil is returned, which disables sending mails.
+func NewSMTPSender(conf config.Mail) *SMTPSender {
+	if conf.Host == "" {
+		return nil
+	}
+	return &SMTPSender{Host: conf.Host, Port: conf.Port, User: conf.User, Pass: conf.Pass, From: conf.From}
+}
+
+// Send takes a message and delivers it to the configured SMTP server.
+// The connection is upgraded with STARTTLS if the server supports it.
+func (s *SMTPSender) Send(m *Message) error {
+	from, err := mail.ParseAddress(s.From)
+	if err != nil {
+		return fmt.Errorf("invalid sender address: %s", err)
+	}
+
+	var auth smtp.Auth
+	if s.User != "" {
+		auth = smtp.PlainAuth("", s.User, s.Pass, s.Host)
+	}
+
+	addr := net.JoinHostPort(s.Host, strconv.Itoa(s.Port))
+	return smtp.SendMail(addr, auth, from.Address, []string{m.To}, m.Bytes(s.From))
+}
+
+// Bytes takes the sender and returns the message formatted as an RFC 5322 mail
+func (m *Message) Bytes(from string) []byte {
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", m.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(m.Body, "\r\n", "\n"), "\n", "\r\n"))
+
+	return b.Bytes()
+}
+
+// MemorySender keeps all mails in memory instead of delivering them. It is meant to be used in tests.
+type MemorySender struct {
+	mu   sync.Mutex
+	sent []*Message
+	// if set, Send fails with this error
+	Err error
+}
+
+// Send takes a message and stores it
+func (s *MemorySender) Send(m *Message) error {
+	if m.To == "" {
+		return errors.New("no recipient")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Err != nil {
+		return s.Err
+	}
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+// Sent returns all messages sent so far
+func (s *MemorySender) Sent() []*Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]*Message(nil), s.sent...)
+}
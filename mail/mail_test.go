@@ -0,0 +1,62 @@
+package mail
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+	"learningbay24.de/backend/dbi"
+	"learningbay24.de/backend/models"
+)
+
+type notificationData struct {
+	Name         string
+	BaseURL      string
+	Notification *models.Notification
+}
+
+func TestRenderLanguages(t *testing.T) {
+	n := &models.Notification{Title: "Exam graded", Body: null.StringFrom("Your answer has been graded."), URL: null.StringFrom("/users/exams/attended")}
+	data := notificationData{Name: "Ada", BaseURL: "https://learningbay24.de", Notification: n}
+
+	de, err := Render(dbi.GermanLanguageId, "notification", data)
+	assert.NoError(t, err)
+	assert.Equal(t, "[LearningBay24] Exam graded", de.Subject)
+	assert.True(t, strings.HasPrefix(de.Body, "Hallo Ada,"))
+	assert.Contains(t, de.Body, "https://learningbay24.de/users/exams/attended")
+
+	en, err := Render(dbi.EnglishLanguageId, "notification", data)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(en.Body, "Hello Ada,"))
+
+	// unknown languages fall back to German
+	fallback, err := Render(9999, "notification", data)
+	assert.NoError(t, err)
+	assert.Equal(t, de, fallback)
+
+	_, err = Render(dbi.GermanLanguageId, "unknown", data)
+	assert.Error(t, err)
+}
+
+func TestMessageBytes(t *testing.T) {
+	m := &Message{To: "ada@learningbay24.de", Subject: "Prüfung", Body: "line one\nline two\n"}
+	b := string(m.Bytes("noreply@learningbay24.de"))
+
+	assert.Contains(t, b, "To: ada@learningbay24.de\r\n")
+	assert.Contains(t, b, "Subject: =?utf-8?q?Pr=C3=BCfung?=\r\n")
+	assert.True(t, strings.HasSuffix(b, "\r\n\r\nline one\r\nline two\r\n"))
+}
+
+func TestMemorySender(t *testing.T) {
+	s := &MemorySender{}
+
+	assert.NoError(t, s.Send(&Message{To: "ada@learningbay24.de", Subject: "subject"}))
+	assert.Error(t, s.Send(&Message{Subject: "no recipient"}))
+	assert.Len(t, s.Sent(), 1)
+
+	s.Err = errors.New("unavailable")
+	assert.Error(t, s.Send(&Message{To: "ada@learningbay24.de"}))
+	assert.Len(t, s.Sent(), 1)
+}
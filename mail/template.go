@@ -0,0 +1,50 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"learningbay24.de/backend/dbi"
+)
+
+//go:embed templates/*.tmpl
+var templateFiles embed.FS
+
+// template file of each language, languages without templates fall back to German
+var languageFiles = map[int]string{
+	dbi.GermanLanguageId:  "templates/de.tmpl",
+	dbi.EnglishLanguageId: "templates/en.tmpl",
+}
+
+var templates = make(map[int]*template.Template)
+
+func init() {
+	for id, file := range languageFiles {
+		templates[id] = template.Must(template.ParseFS(templateFiles, file))
+	}
+}
+
+// Render takes the ID of a language, the name of a template and the data passed to it and returns the rendered message without a recipient.
+// Every template consists of the two templates `<name>_subject` and `<name>_body`.
+func Render(languageId int, name string, data interface{}) (*Message, error) {
+	t, ok := templates[languageId]
+	if !ok {
+		t = templates[dbi.GermanLanguageId]
+	}
+
+	var subject, body bytes.Buffer
+	if t.Lookup(name+"_subject") == nil || t.Lookup(name+"_body") == nil {
+		return nil, fmt.Errorf("unknown mail template: %s", name)
+	}
+	if err := t.ExecuteTemplate(&subject, name+"_subject", data); err != nil {
+		return nil, err
+	}
+	if err := t.ExecuteTemplate(&body, name+"_body", data); err != nil {
+		return nil, err
+	}
+
+	return &Message{Subject: strings.TrimSpace(subject.String()), Body: strings.TrimSpace(body.String()) + "\n"}, nil
+}
@@ -2,16 +2,22 @@ package main
 
 import (
 	"database/sql"
-	"fmt"
+	"errors"
 	"net/http"
-	"strconv"
 	"strings"
 
 	"learningbay24.de/backend/api"
 	"learningbay24.de/backend/config"
 	"learningbay24.de/backend/dbi"
+	"learningbay24.de/backend/janitor"
+	"learningbay24.de/backend/mail"
+	"learningbay24.de/backend/notification"
+	"learningbay24.de/backend/preview"
+	"learningbay24.de/backend/recovery"
+	"learningbay24.de/backend/scanner"
+	"learningbay24.de/backend/session"
+	"learningbay24.de/backend/storage"
 
-	"github.com/dgrijalva/jwt-go"
 	"github.com/gin-gonic/gin"
 	migrate "github.com/rubenv/sql-migrate"
 	log "github.com/sirupsen/logrus"
@@ -46,10 +52,15 @@ func setupEnvironment(db *sql.DB) {
 
 func CORSMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, GET, DELETE, PATCH, OPTIONS")
+		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, GET, HEAD, DELETE, PATCH, OPTIONS")
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "https://learningbay24.de")
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type, Tus-Resumable, Upload-Length, Upload-Offset, Upload-Metadata")
+		c.Writer.Header().Set("Access-Control-Expose-Headers", "Location, Upload-Offset, Upload-Length, Tus-Resumable, Tus-Version, Tus-Extension")
 		if c.Request.Method == "OPTIONS" {
+			if strings.HasPrefix(c.Request.URL.Path, "/uploads") {
+				api.SetTusHeaders(c)
+			}
 			c.AbortWithStatus(204)
 		} else {
 			c.Next()
@@ -57,70 +68,49 @@ func CORSMiddleware() gin.HandlerFunc {
 	}
 }
 
-func AuthMiddleware() gin.HandlerFunc {
+// AuthMiddleware lets requests with a valid access token of an active session pass, setting the IDs of the user, the user's role and the session
+func AuthMiddleware(db *sql.DB) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		flog := log.WithFields(log.Fields{
 			"context": "auth_middleware",
 		})
 
-		cookie := c.Request.Header.Get("Cookie")
-		if cookie == "" {
-			flog.Errorf("Unable to get cookie")
+		tokenString, err := api.TokenFromRequest(c)
+		if err != nil {
+			flog.Infof("Unable to get token: %s", err.Error())
 			c.AbortWithStatus(http.StatusUnauthorized)
 			return
 		}
-		tokenString := strings.Split(cookie, "=")[1]
-
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-			}
 
-			return []byte(config.Conf.Secrets.JWTSecret), nil
-		})
+		identity, err := api.ParseAccessToken(tokenString)
 		if err != nil {
 			flog.Errorf("Error parsing token: %s", err.Error())
 			c.AbortWithStatus(http.StatusUnauthorized)
 			return
 		}
+		id, role_id, session_id := identity.UserID, identity.RoleID, identity.SessionID
 
-		data, ok := token.Claims.(jwt.MapClaims)["data"]
-		if !ok {
-			flog.Error("Unable to map id from data interface")
-			c.AbortWithStatus(http.StatusUnauthorized)
-			return
-		}
-
-		datamap, ok := data.(map[string]interface{})
-		if !ok {
-			flog.Error("Unable to map id from data interface")
+		// ensure basic permissions
+		if !api.AuthorizeUser(role_id) {
+			flog.Error("Token's role_id does not have user permissions")
 			c.AbortWithStatus(http.StatusUnauthorized)
 			return
 		}
 
-		id, err := strconv.Atoi(datamap["id"].(string))
-		if err != nil {
-			flog.Errorf("Unable to convert id to int: %s", err.Error())
+		err = session.Validate(db, session_id, id, c.ClientIP())
+		if errors.Is(err, session.ErrInvalid) {
+			flog.Infof("Session %d of user %d was revoked or expired", session_id, id)
 			c.AbortWithStatus(http.StatusUnauthorized)
 			return
-		}
-
-		role_id, err := strconv.Atoi(datamap["role_id"].(string))
-		if err != nil {
-			flog.Errorf("Unable to convert role_id to int: %s", err.Error())
-			c.AbortWithStatus(http.StatusUnauthorized)
-			return
-		}
-
-		// ensure basic permissions
-		if !api.AuthorizeUser(role_id) {
-			flog.Error("Cookie's role_id does not have user permissions")
-			c.AbortWithStatus(http.StatusUnauthorized)
+		} else if err != nil {
+			flog.Errorf("Unable to validate session: %s", err.Error())
+			c.AbortWithStatus(http.StatusInternalServerError)
 			return
 		}
 
 		c.Set("CookieUserId", id)
 		c.Set("CookieRoleId", role_id)
+		c.Set("CookieSessionId", session_id)
 		c.Next()
 	}
 }
@@ -130,13 +120,38 @@ func main() {
 	config.InitLogger()
 	db := config.SetupDbHandle()
 	applyMigrations(db)
+	scanner.Init()
+	if err := storage.Init(); err != nil {
+		log.Fatalf("Unable to set up file storage: %s. Aborting.", err.Error())
+	}
+	if config.Conf.RepairQuotas {
+		n, err := dbi.RepairUploadedBytes(db)
+		if err != nil {
+			log.Fatalf("Unable to repair quotas: %s. Aborting.", err.Error())
+		}
+		log.Infof("Corrected the uploaded bytes of %d users", n)
+		return
+	}
+	preview.Start(db, config.Conf.Files.PreviewWorkers)
 	setupEnvironment(db)
 
+	if sender := mail.NewSMTPSender(config.Conf.Mail); sender != nil {
+		notification.MailSender = sender
+		recovery.MailSender = sender
+		go notification.ScheduleDigests(db, config.Conf.Mail.DigestHour)
+	}
+
+	if config.Conf.Janitor.IntervalHours != 0 {
+		go janitor.Schedule(db, config.Conf.Janitor)
+	}
+
 	pCtrl := api.PublicController{Database: db}
-	router := gin.Default()
+	router := gin.New()
+	// same as gin.Default, but aborting connections has to happen outside of Recovery
+	router.Use(api.AbortMiddleware(), gin.Logger(), gin.Recovery())
 	router.Use(CORSMiddleware())
 
-	auth := router.Group("").Use(AuthMiddleware())
+	auth := router.Group("").Use(AuthMiddleware(db))
 	{
 		auth.GET("/courses/:id", pCtrl.GetCourseById)
 		auth.DELETE("/courses/:id/:user_id", pCtrl.DeleteUserFromCourse)
@@ -147,10 +162,14 @@ func main() {
 		auth.POST("/courses/:id", pCtrl.EnrollUser)
 		auth.PATCH("/courses/:id", pCtrl.EditCourseById)
 		auth.POST("/logout", pCtrl.Logout)
+		auth.GET("/users/sessions", pCtrl.GetSessionsFromUser)
+		auth.DELETE("/users/sessions/:id", pCtrl.RevokeSession)
+		auth.DELETE("/users/:id/sessions", pCtrl.RevokeUserSessions)
 		auth.POST("/register", pCtrl.Register)
 		auth.POST("/courses/:id/files", pCtrl.UploadMaterial)
 		auth.GET("/courses/:id/files", pCtrl.GetMaterialsFromCourse)
 		auth.GET("/courses/:id/files/:file_id", pCtrl.GetMaterialFromCourse)
+		auth.GET("/courses/:id/files/:file_id/preview", pCtrl.GetMaterialPreview)
 		auth.DELETE("/courses/:id/files/:file_id", pCtrl.DeleteMaterialFromCourse)
 		auth.DELETE("/users/:id", pCtrl.DeleteUser)
 		auth.GET("/users/cookie", pCtrl.GetUserByCookie)
@@ -181,6 +200,7 @@ func main() {
 		auth.PATCH("/courses/submissions/:submission_id", pCtrl.EditSubmissionById)
 		auth.GET("/users/submissions", pCtrl.GetSubmissionFromUser)
 		auth.POST("/courses/submissions/:submission_id/files", pCtrl.CreateSubmissionHasFiles)
+		auth.GET("/courses/submissions/:submission_id/limits", pCtrl.GetSubmissionUploadLimits)
 		auth.DELETE("/courses/submissions/:submission_id/files/:file_id", pCtrl.DeleteSubmissionHasFiles)
 		auth.POST("/courses/submissions/:submission_id/usersubmissions", pCtrl.CreateUserSubmission)
 		auth.DELETE("/courses/submissions/usersubmissions/:usersubmission_id", pCtrl.DeleteUserSubmission)
@@ -188,9 +208,60 @@ func main() {
 		auth.DELETE("/courses/submissions/usersubmissions/:usersubmission_id/files/:file_id", pCtrl.DeleteUserSubmissionHasFiles)
 		auth.GET("/courses/:id/submissions", pCtrl.GetSubmissionsFromCourse)
 		auth.PATCH("/courses/submissions/usersubmissions/:usersubmission_id/grade", pCtrl.GradeUserSubmission)
+		auth.GET("/courses/:id/forum", pCtrl.GetForumThreads)
+		auth.POST("/courses/:id/forum", pCtrl.CreateForumThread)
+		auth.GET("/courses/:id/forum/:entry_id", pCtrl.GetForumThread)
+		auth.POST("/courses/:id/forum/:entry_id", pCtrl.ReplyToForumEntry)
+		auth.PATCH("/courses/:id/forum/:entry_id", pCtrl.EditForumEntry)
+		auth.DELETE("/courses/:id/forum/:entry_id", pCtrl.DeleteForumEntry)
+		auth.GET("/courses/:id/forum/log", pCtrl.GetForumModerationLog)
+		auth.PATCH("/courses/:id/forum/:entry_id/pin", pCtrl.PinForumThread)
+		auth.PATCH("/courses/:id/forum/:entry_id/lock", pCtrl.LockForumThread)
+		auth.PATCH("/courses/:id/forum/:entry_id/hide", pCtrl.HideForumEntry)
+		auth.GET("/users/notifications", pCtrl.GetNotifications)
+		auth.GET("/users/notifications/stream", pCtrl.StreamNotifications)
+		auth.PATCH("/users/notifications/read", pCtrl.MarkAllNotificationsRead)
+		auth.PATCH("/users/notifications/mail", pCtrl.SetNotificationMailPreference)
+		auth.PATCH("/users/notifications/:id/read", pCtrl.MarkNotificationRead)
+		auth.DELETE("/users/notifications/:id", pCtrl.DeleteNotification)
+		auth.GET("/users/certificates", pCtrl.GetCertificatesFromUser)
+		auth.GET("/users/certificates/:id", pCtrl.GetCertificate)
+		auth.GET("/users/certificates/:id/pdf", pCtrl.GetCertificatePDF)
+		auth.GET("/courses/:id/prerequisites", pCtrl.GetPrerequisitesOfCourse)
+		auth.POST("/courses/:id/prerequisites", pCtrl.AddPrerequisiteToCourse)
+		auth.DELETE("/courses/:id/prerequisites/:required_course_id", pCtrl.RemovePrerequisiteFromCourse)
+		auth.GET("/courses/:id/directories", pCtrl.GetDirectoriesFromCourse)
+		auth.POST("/courses/:id/directories", pCtrl.CreateDirectory)
+		auth.GET("/courses/:id/directories/:directory_id", pCtrl.GetMaterialsFromDirectory)
+		auth.PATCH("/courses/:id/directories/:directory_id", pCtrl.EditDirectory)
+		auth.DELETE("/courses/:id/directories/:directory_id", pCtrl.DeleteDirectory)
+		auth.POST("/courses/:id/directories/:directory_id/files", pCtrl.UploadMaterialToDirectory)
+		auth.PATCH("/courses/:id/files/:file_id/directory", pCtrl.MoveMaterial)
+		auth.GET("/courses/:id/archive", pCtrl.GetCourseArchive)
+		auth.GET("/courses/:id/directories/:directory_id/archive", pCtrl.GetDirectoryArchive)
+		auth.POST("/fieldsofstudy", pCtrl.CreateFieldOfStudy)
+		auth.PATCH("/fieldsofstudy/:id", pCtrl.EditFieldOfStudy)
+		auth.DELETE("/fieldsofstudy/:id", pCtrl.DeleteFieldOfStudy)
+		auth.POST("/fieldsofstudy/:id/courses", pCtrl.SetCurriculumCourse)
+		auth.DELETE("/fieldsofstudy/:id/courses/:course_id", pCtrl.RemoveCurriculumCourse)
+		auth.GET("/users/fieldsofstudy", pCtrl.GetFieldsOfStudyFromUser)
+		auth.POST("/users/fieldsofstudy/:id", pCtrl.EnrollInFieldOfStudy)
+		auth.DELETE("/users/fieldsofstudy/:id", pCtrl.UnenrollFromFieldOfStudy)
+		auth.GET("/users/progress", pCtrl.GetProgressFromUser)
+		auth.GET("/users/quota", pCtrl.GetQuotaFromUser)
+		auth.POST("/users/quota/repair", pCtrl.RepairQuotas)
+		auth.PATCH("/users/:user_id/quota", pCtrl.SetUserQuota)
+		auth.PATCH("/roles/:id/quota", pCtrl.SetRoleQuota)
+		auth.POST("/uploads", pCtrl.CreateUpload)
+		auth.HEAD("/uploads/:id", pCtrl.GetUpload)
+		auth.PATCH("/uploads/:id", pCtrl.PatchUpload)
+		auth.DELETE("/uploads/:id", pCtrl.DeleteUpload)
 	}
 
 	router.POST("/login", pCtrl.Login)
+	router.POST("/refresh", pCtrl.Refresh)
+	router.POST("/password/forgot", pCtrl.ForgotPassword)
+	router.POST("/password/reset", pCtrl.ResetPassword)
 	// TODO: add authorization => user has access to submission
 	router.GET("/submissions/:id", pCtrl.GetSubmission)
 	// TODO: add authorization => user
@@ -200,6 +271,10 @@ func main() {
 	// TODO: add authorization => user
 	router.GET("/courses/submissions/usersubmissions/:usersubmission_id/files", pCtrl.GetFileFromUserSubmission)
 	router.GET("/courses/search", pCtrl.SearchCourse)
+	router.GET("/certificates/:uuid/verify", pCtrl.VerifyCertificate)
+	router.GET("/fieldsofstudy", pCtrl.GetFieldsOfStudy)
+	router.GET("/fieldsofstudy/:id", pCtrl.GetFieldOfStudy)
+	router.GET("/fieldsofstudy/:id/curriculum", pCtrl.GetCurriculum)
 	// TODO: add authorization?
 	router.POST("/appointments/add", pCtrl.AddCourseToCalender)
 	// TODO: add authorization?
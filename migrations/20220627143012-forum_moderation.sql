@@ -0,0 +1,35 @@
+-- +migrate Up
+ALTER TABLE `forum_entry` ADD `pinned` tinyint(4) NOT NULL DEFAULT 0 COMMENT 'Whether the thread is pinned to the top of the forum. Only used for top-level entries.';
+ALTER TABLE `forum_entry` ADD `locked` tinyint(4) NOT NULL DEFAULT 0 COMMENT 'Whether replying to the thread is disallowed. Only used for top-level entries.';
+ALTER TABLE `forum_entry` ADD `hidden_at` timestamp NULL DEFAULT NULL COMMENT 'When the entry was hidden by a moderator.';
+ALTER TABLE `forum_entry` ADD `hidden_by_id` int(11) NULL COMMENT 'The moderator that hid this entry.';
+ALTER TABLE `forum_entry` ADD `hidden_reason` varchar(256) COLLATE utf8_unicode_ci DEFAULT NULL COMMENT 'Why the entry was hidden by a moderator.';
+ALTER TABLE `forum_entry` ADD CONSTRAINT `fk_forum_entry_user2` FOREIGN KEY (`hidden_by_id`) REFERENCES `user` (`id`);
+
+CREATE TABLE `forum_moderation_log` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `forum_id` int(11) NOT NULL COMMENT 'The forum the moderated entry belongs to.',
+  `entry_id` int(11) NOT NULL COMMENT 'The entry that was moderated.',
+  `moderator_id` int(11) NOT NULL COMMENT 'The moderator that performed the action.',
+  `action` enum('pin','unpin','lock','unlock','hide','unhide') COLLATE utf8_unicode_ci NOT NULL COMMENT 'The moderation action that was performed.',
+  `reason` varchar(256) COLLATE utf8_unicode_ci DEFAULT NULL COMMENT 'The reason given by the moderator, if any.',
+  `created_at` timestamp NOT NULL DEFAULT current_timestamp() COMMENT 'When the action was performed.',
+  PRIMARY KEY (`id`),
+  KEY `fk_forum_moderation_log_forum1_idx` (`forum_id`),
+  KEY `fk_forum_moderation_log_forum_entry1_idx` (`entry_id`),
+  KEY `fk_forum_moderation_log_user1_idx` (`moderator_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COLLATE=utf8_unicode_ci COMMENT='Log of all moderation actions performed in a forum.';
+
+ALTER TABLE `forum_moderation_log`
+	ADD CONSTRAINT `fk_forum_moderation_log_forum1` FOREIGN KEY (`forum_id`) REFERENCES `forum` (`id`),
+	ADD CONSTRAINT `fk_forum_moderation_log_forum_entry1` FOREIGN KEY (`entry_id`) REFERENCES `forum_entry` (`id`),
+	ADD CONSTRAINT `fk_forum_moderation_log_user1` FOREIGN KEY (`moderator_id`) REFERENCES `user` (`id`);
+
+-- +migrate Down
+DROP TABLE `forum_moderation_log`;
+ALTER TABLE `forum_entry` DROP CONSTRAINT `fk_forum_entry_user2`;
+ALTER TABLE `forum_entry` DROP COLUMN `hidden_reason`;
+ALTER TABLE `forum_entry` DROP COLUMN `hidden_by_id`;
+ALTER TABLE `forum_entry` DROP COLUMN `hidden_at`;
+ALTER TABLE `forum_entry` DROP COLUMN `locked`;
+ALTER TABLE `forum_entry` DROP COLUMN `pinned`;
@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE `user` ADD `notification_mail` enum('none','immediate','digest') COLLATE utf8_unicode_ci NOT NULL DEFAULT 'digest' COMMENT 'Whether notifications are sent to the user by mail immediately, as a daily digest or not at all.';
+ALTER TABLE `notification` ADD `mailed_at` timestamp NULL DEFAULT NULL COMMENT 'The time this notification was sent to the user by mail.';
+
+-- +migrate Down
+ALTER TABLE `notification` DROP COLUMN `mailed_at`;
+ALTER TABLE `user` DROP COLUMN `notification_mail`;
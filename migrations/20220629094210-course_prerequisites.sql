@@ -0,0 +1,46 @@
+-- +migrate Up
+-- prerequisites refer to the required course instead of a single certificate, which belongs to one user only
+RENAME TABLE `course_requires_certificate` TO `course_requires_certificate_old`;
+
+CREATE TABLE `course_requires_certificate` (
+  `course_id` int(11) NOT NULL COMMENT 'The course that can only be enrolled in with a certificate of the required course.',
+  `required_course_id` int(11) NOT NULL COMMENT 'The course a certificate is required of.',
+  PRIMARY KEY (`course_id`,`required_course_id`),
+  KEY `fk_course_requires_certificate_course1_idx` (`course_id`),
+  KEY `fk_course_requires_certificate_course2_idx` (`required_course_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COLLATE=utf8_unicode_ci;
+
+-- a required certificate becomes a requirement of the course it was issued for
+INSERT INTO `course_requires_certificate` (`course_id`, `required_course_id`)
+	SELECT DISTINCT `old`.`course_id`, `certificate`.`linked_course_id`
+	FROM `course_requires_certificate_old` `old`
+	JOIN `certificate` ON `certificate`.`id` = `old`.`certificate_id`;
+
+DROP TABLE `course_requires_certificate_old`;
+
+ALTER TABLE `course_requires_certificate`
+	ADD CONSTRAINT `fk_course_requires_certificate_course1` FOREIGN KEY (`course_id`) REFERENCES `course` (`id`),
+	ADD CONSTRAINT `fk_course_requires_certificate_course2` FOREIGN KEY (`required_course_id`) REFERENCES `course` (`id`);
+
+-- +migrate Down
+RENAME TABLE `course_requires_certificate` TO `course_requires_certificate_new`;
+
+CREATE TABLE `course_requires_certificate` (
+  `course_id` int(11) NOT NULL,
+  `certificate_id` char(36) COLLATE utf8_unicode_ci NOT NULL,
+  PRIMARY KEY (`course_id`,`certificate_id`),
+  KEY `fk_certificate_has_course_course1_idx` (`course_id`),
+  KEY `fk_certificate_has_course_certificate1_idx` (`certificate_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COLLATE=utf8_unicode_ci;
+
+-- a required course becomes a requirement of each certificate issued for it
+INSERT INTO `course_requires_certificate` (`course_id`, `certificate_id`)
+	SELECT `new`.`course_id`, `certificate`.`id`
+	FROM `course_requires_certificate_new` `new`
+	JOIN `certificate` ON `certificate`.`linked_course_id` = `new`.`required_course_id`;
+
+DROP TABLE `course_requires_certificate_new`;
+
+ALTER TABLE `course_requires_certificate`
+	ADD CONSTRAINT `fk_certificate_has_course_certificate1` FOREIGN KEY (`certificate_id`) REFERENCES `certificate` (`id`),
+	ADD CONSTRAINT `fk_certificate_has_course_course1` FOREIGN KEY (`course_id`) REFERENCES `course` (`id`);
@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE `submission` ADD `allowed_file_types` varchar(255) COLLATE utf8_unicode_ci DEFAULT NULL COMMENT 'Comma separated list of the file extensions allowed for solutions to this submission. If NULL, all globally allowed file types are accepted.';
+
+-- +migrate Down
+ALTER TABLE `submission` DROP COLUMN `allowed_file_types`;
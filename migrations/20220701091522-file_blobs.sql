@@ -0,0 +1,19 @@
+-- +migrate Up
+CREATE TABLE `file_blob` (
+  `hash` char(64) COLLATE utf8_unicode_ci NOT NULL COMMENT 'SHA-256 of the content, hex encoded.',
+  `uri` varchar(256) COLLATE utf8_unicode_ci NOT NULL COMMENT 'Where the content is stored.',
+  `size` bigint(20) NOT NULL COMMENT 'Size of the content in bytes.',
+  `ref_count` int(11) NOT NULL DEFAULT 1 COMMENT 'Number of files sharing the content. The content is removed once no file refers to it anymore.',
+  `created_at` timestamp NOT NULL DEFAULT current_timestamp(),
+  PRIMARY KEY (`hash`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COLLATE=utf8_unicode_ci COMMENT='Content of local files. Files with the same content share one blob.';
+
+ALTER TABLE `file` ADD `hash` char(64) COLLATE utf8_unicode_ci DEFAULT NULL COMMENT 'Blob holding the content of a local file. NULL for remote files and local files uploaded before content was deduplicated.',
+	ADD KEY `fk_file_file_blob1_idx` (`hash`),
+	ADD CONSTRAINT `fk_file_file_blob1` FOREIGN KEY (`hash`) REFERENCES `file_blob` (`hash`);
+
+-- +migrate Down
+ALTER TABLE `file` DROP FOREIGN KEY `fk_file_file_blob1`;
+ALTER TABLE `file` DROP COLUMN `hash`;
+
+DROP TABLE `file_blob`;
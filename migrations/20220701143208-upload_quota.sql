@@ -0,0 +1,15 @@
+-- +migrate Up
+ALTER TABLE `user` MODIFY `uploaded_bytes` bigint(20) NOT NULL DEFAULT 0 COMMENT 'Size of all local files the user uploaded that aren''t deleted.',
+	ADD `upload_quota` bigint(20) DEFAULT NULL COMMENT 'Number of bytes the user can upload in total, overriding the quota of the role. 0 = unlimited, NULL = use the quota of the role.';
+
+ALTER TABLE `role` ADD `upload_quota` bigint(20) DEFAULT NULL COMMENT 'Number of bytes users with this role can upload in total. 0 = unlimited, NULL = use MaxUploadPerUser from the config.';
+
+ALTER TABLE `file` ADD `size` bigint(20) NOT NULL DEFAULT 0 COMMENT 'Size of a local file in bytes.';
+
+-- +migrate Down
+ALTER TABLE `file` DROP COLUMN `size`;
+
+ALTER TABLE `role` DROP COLUMN `upload_quota`;
+
+ALTER TABLE `user` DROP COLUMN `upload_quota`,
+	MODIFY `uploaded_bytes` int(64) NOT NULL DEFAULT 0;
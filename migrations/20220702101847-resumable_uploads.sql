@@ -0,0 +1,20 @@
+-- +migrate Up
+CREATE TABLE `upload` (
+  `id` char(36) COLLATE utf8_unicode_ci NOT NULL,
+  `user_id` int(11) NOT NULL COMMENT 'User uploading the file.',
+  `target` varchar(16) COLLATE utf8_unicode_ci NOT NULL COMMENT 'What the file is attached to once it is complete: material, exam, answer or submission.',
+  `target_id` int(11) NOT NULL COMMENT 'ID of the course, exam or user submission the file is attached to.',
+  `file_name` varchar(64) COLLATE utf8_unicode_ci NOT NULL,
+  `length` bigint(20) NOT NULL COMMENT 'Size of the complete file in bytes.',
+  `upload_offset` bigint(20) NOT NULL DEFAULT 0 COMMENT 'Number of bytes received so far.',
+  `created_at` timestamp NOT NULL DEFAULT current_timestamp(),
+  `finished_at` timestamp NULL DEFAULT NULL COMMENT 'When the complete file was attached to its target.',
+  PRIMARY KEY (`id`),
+  KEY `fk_upload_user1_idx` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COLLATE=utf8_unicode_ci COMMENT='A resumable upload using the tus protocol. The received bytes are kept on the local disk until the upload is complete.';
+
+ALTER TABLE `upload`
+	ADD CONSTRAINT `fk_upload_user1` FOREIGN KEY (`user_id`) REFERENCES `user` (`id`);
+
+-- +migrate Down
+DROP TABLE `upload`;
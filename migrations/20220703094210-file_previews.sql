@@ -0,0 +1,9 @@
+-- +migrate Up
+ALTER TABLE `file` ADD `mime_type` varchar(127) COLLATE utf8_unicode_ci DEFAULT NULL COMMENT 'MIME type of a local file. NULL for remote files and local files uploaded before it was recorded.';
+
+ALTER TABLE `file_blob` ADD `preview_uri` varchar(256) COLLATE utf8_unicode_ci DEFAULT NULL COMMENT 'Where the preview image of the content is stored. NULL if there is no preview (yet).';
+
+-- +migrate Down
+ALTER TABLE `file_blob` DROP COLUMN `preview_uri`;
+
+ALTER TABLE `file` DROP COLUMN `mime_type`;
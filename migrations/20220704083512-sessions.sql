@@ -0,0 +1,21 @@
+-- +migrate Up
+CREATE TABLE `session` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `user_id` int(11) NOT NULL,
+  `refresh_token_hash` char(64) COLLATE utf8_unicode_ci NOT NULL COMMENT 'SHA-256 of the current refresh token, hex encoded.',
+  `previous_token_hash` char(64) COLLATE utf8_unicode_ci DEFAULT NULL COMMENT 'SHA-256 of the refresh token replaced last. Using it again means it was stolen, so the session is revoked.',
+  `device` varchar(256) COLLATE utf8_unicode_ci NOT NULL COMMENT 'User agent of the client.',
+  `ip` varchar(45) COLLATE utf8_unicode_ci NOT NULL COMMENT 'IP address the session was used from last.',
+  `created_at` timestamp NOT NULL DEFAULT current_timestamp(),
+  `last_seen_at` timestamp NOT NULL DEFAULT current_timestamp(),
+  `expires_at` timestamp NOT NULL COMMENT 'The session ends unless it is refreshed before.',
+  `revoked_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `refresh_token_hash_UNIQUE` (`refresh_token_hash`),
+  KEY `previous_token_hash_idx` (`previous_token_hash`),
+  KEY `fk_session_user1_idx` (`user_id`),
+  CONSTRAINT `fk_session_user1` FOREIGN KEY (`user_id`) REFERENCES `user` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COLLATE=utf8_unicode_ci COMMENT='Logins of users on a device, kept alive by rotating refresh tokens.';
+
+-- +migrate Down
+DROP TABLE `session`;
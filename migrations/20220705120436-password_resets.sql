@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE `password_reset` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `user_id` int(11) NOT NULL,
+  `token_hash` char(64) COLLATE utf8_unicode_ci NOT NULL COMMENT 'SHA-256 of the token sent to the user, hex encoded.',
+  `created_at` timestamp NOT NULL DEFAULT current_timestamp(),
+  `expires_at` timestamp NOT NULL,
+  `used_at` timestamp NULL DEFAULT NULL COMMENT 'When the token was used or replaced by resetting the password with another one.',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `token_hash_UNIQUE` (`token_hash`),
+  KEY `fk_password_reset_user1_idx` (`user_id`),
+  CONSTRAINT `fk_password_reset_user1` FOREIGN KEY (`user_id`) REFERENCES `user` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COLLATE=utf8_unicode_ci COMMENT='Single-use tokens users reset their forgotten password with.';
+
+-- +migrate Down
+DROP TABLE `password_reset`;
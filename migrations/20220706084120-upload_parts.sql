@@ -0,0 +1,21 @@
+-- +migrate Up
+CREATE TABLE `upload_part` (
+  `upload_id` char(36) COLLATE utf8_unicode_ci NOT NULL,
+  `offset` bigint(20) NOT NULL COMMENT 'Position of the first byte of the part within the upload.',
+  `uri` varchar(256) COLLATE utf8_unicode_ci NOT NULL COMMENT 'Where the bytes of the part are stored.',
+  `size` bigint(20) NOT NULL COMMENT 'Number of bytes in the part.',
+  PRIMARY KEY (`upload_id`,`offset`),
+  CONSTRAINT `fk_upload_part_upload1` FOREIGN KEY (`upload_id`) REFERENCES `upload` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COLLATE=utf8_unicode_ci COMMENT='Bytes received by one request of a resumable upload.';
+
+ALTER TABLE `upload` ADD `locked_at` timestamp NULL DEFAULT NULL COMMENT 'When a request started appending to the upload, NULL if none is.';
+ALTER TABLE `upload` COMMENT='A resumable upload using the tus protocol. The received bytes are kept in the storage backend in parts until the upload is complete.';
+
+-- the bytes of running uploads were kept on the local disk of the instance receiving them, those uploads have to be started again
+DELETE FROM `upload` WHERE `finished_at` IS NULL;
+
+-- +migrate Down
+DROP TABLE `upload_part`;
+
+ALTER TABLE `upload` DROP COLUMN `locked_at`;
+ALTER TABLE `upload` COMMENT='A resumable upload using the tus protocol. The received bytes are kept on the local disk until the upload is complete.';
@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE `session` ADD `refreshed_at` timestamp NOT NULL DEFAULT current_timestamp() COMMENT 'When the refresh token was replaced last. A replaced token used shortly after is a concurrent refresh rather than a stolen token.' AFTER `last_seen_at`;
+
+-- +migrate Down
+ALTER TABLE `session` DROP COLUMN `refreshed_at`;
@@ -16,14 +16,20 @@ var TableNames = struct {
 	FieldOfStudy              string
 	FieldOfStudyHasCourse     string
 	File                      string
+	FileBlob                  string
 	Forum                     string
 	ForumEntry                string
+	ForumModerationLog        string
 	GraduationLevel           string
 	Language                  string
 	Notification              string
+	PasswordReset             string
 	Role                      string
+	Session                   string
 	Submission                string
 	SubmissionHasFiles        string
+	Upload                    string
+	UploadPart                string
 	User                      string
 	UserHasCourse             string
 	UserHasExam               string
@@ -43,14 +49,20 @@ var TableNames = struct {
 	FieldOfStudy:              "field_of_study",
 	FieldOfStudyHasCourse:     "field_of_study_has_course",
 	File:                      "file",
+	FileBlob:                  "file_blob",
 	Forum:                     "forum",
 	ForumEntry:                "forum_entry",
+	ForumModerationLog:        "forum_moderation_log",
 	GraduationLevel:           "graduation_level",
 	Language:                  "language",
 	Notification:              "notification",
+	PasswordReset:             "password_reset",
 	Role:                      "role",
+	Session:                   "session",
 	Submission:                "submission",
 	SubmissionHasFiles:        "submission_has_files",
+	Upload:                    "upload",
+	UploadPart:                "upload_part",
 	User:                      "user",
 	UserHasCourse:             "user_has_course",
 	UserHasExam:               "user_has_exam",
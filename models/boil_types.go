@@ -50,3 +50,69 @@ func makeCacheKey(cols boil.Columns, nzDefaults []string) string {
 	strmangle.PutBuffer(buf)
 	return str
 }
+
+type ForumModerationLogAction string
+
+// Enum values for ForumModerationLogAction
+const (
+	ForumModerationLogActionPin    ForumModerationLogAction = "pin"
+	ForumModerationLogActionUnpin  ForumModerationLogAction = "unpin"
+	ForumModerationLogActionLock   ForumModerationLogAction = "lock"
+	ForumModerationLogActionUnlock ForumModerationLogAction = "unlock"
+	ForumModerationLogActionHide   ForumModerationLogAction = "hide"
+	ForumModerationLogActionUnhide ForumModerationLogAction = "unhide"
+)
+
+func AllForumModerationLogAction() []ForumModerationLogAction {
+	return []ForumModerationLogAction{
+		ForumModerationLogActionPin,
+		ForumModerationLogActionUnpin,
+		ForumModerationLogActionLock,
+		ForumModerationLogActionUnlock,
+		ForumModerationLogActionHide,
+		ForumModerationLogActionUnhide,
+	}
+}
+
+func (e ForumModerationLogAction) IsValid() error {
+	switch e {
+	case ForumModerationLogActionPin, ForumModerationLogActionUnpin, ForumModerationLogActionLock, ForumModerationLogActionUnlock, ForumModerationLogActionHide, ForumModerationLogActionUnhide:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e ForumModerationLogAction) String() string {
+	return string(e)
+}
+
+type UserNotificationMail string
+
+// Enum values for UserNotificationMail
+const (
+	UserNotificationMailNone      UserNotificationMail = "none"
+	UserNotificationMailImmediate UserNotificationMail = "immediate"
+	UserNotificationMailDigest    UserNotificationMail = "digest"
+)
+
+func AllUserNotificationMail() []UserNotificationMail {
+	return []UserNotificationMail{
+		UserNotificationMailNone,
+		UserNotificationMailImmediate,
+		UserNotificationMailDigest,
+	}
+}
+
+func (e UserNotificationMail) IsValid() error {
+	switch e {
+	case UserNotificationMailNone, UserNotificationMailImmediate, UserNotificationMailDigest:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e UserNotificationMail) String() string {
+	return string(e)
+}
@@ -146,20 +146,17 @@ var CertificateRels = struct {
 	LinkedCourse string
 	Exam         string
 	User         string
-	Courses      string
 }{
 	LinkedCourse: "LinkedCourse",
 	Exam:         "Exam",
 	User:         "User",
-	Courses:      "Courses",
 }
 
 // certificateR is where relationships are stored.
 type certificateR struct {
-	LinkedCourse *Course     `boil:"LinkedCourse" json:"LinkedCourse" toml:"LinkedCourse" yaml:"LinkedCourse"`
-	Exam         *Exam       `boil:"Exam" json:"Exam" toml:"Exam" yaml:"Exam"`
-	User         *User       `boil:"User" json:"User" toml:"User" yaml:"User"`
-	Courses      CourseSlice `boil:"Courses" json:"Courses" toml:"Courses" yaml:"Courses"`
+	LinkedCourse *Course `boil:"LinkedCourse" json:"LinkedCourse" toml:"LinkedCourse" yaml:"LinkedCourse"`
+	Exam         *Exam   `boil:"Exam" json:"Exam" toml:"Exam" yaml:"Exam"`
+	User         *User   `boil:"User" json:"User" toml:"User" yaml:"User"`
 }
 
 // NewStruct creates a new relationship struct
@@ -188,13 +185,6 @@ func (r *certificateR) GetUser() *User {
 	return r.User
 }
 
-func (r *certificateR) GetCourses() CourseSlice {
-	if r == nil {
-		return nil
-	}
-	return r.Courses
-}
-
 // certificateL is where Load methods for each relationship are stored.
 type certificateL struct{}
 
@@ -517,21 +507,6 @@ func (o *Certificate) User(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
-// Courses retrieves all the course's Courses with an executor.
-func (o *Certificate) Courses(mods ...qm.QueryMod) courseQuery {
-	var queryMods []qm.QueryMod
-	if len(mods) != 0 {
-		queryMods = append(queryMods, mods...)
-	}
-
-	queryMods = append(queryMods,
-		qm.InnerJoin("`course_requires_certificate` on `course`.`id` = `course_requires_certificate`.`course_id`"),
-		qm.Where("`course_requires_certificate`.`certificate_id`=?", o.ID),
-	)
-
-	return Courses(queryMods...)
-}
-
 // LoadLinkedCourse allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (certificateL) LoadLinkedCourse(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCertificate interface{}, mods queries.Applicator) error {
@@ -851,122 +826,6 @@ func (certificateL) LoadUser(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
-// LoadCourses allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (certificateL) LoadCourses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCertificate interface{}, mods queries.Applicator) error {
-	var slice []*Certificate
-	var object *Certificate
-
-	if singular {
-		object = maybeCertificate.(*Certificate)
-	} else {
-		slice = *maybeCertificate.(*[]*Certificate)
-	}
-
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &certificateR{}
-		}
-		args = append(args, object.ID)
-	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &certificateR{}
-			}
-
-			for _, a := range args {
-				if a == obj.ID {
-					continue Outer
-				}
-			}
-
-			args = append(args, obj.ID)
-		}
-	}
-
-	if len(args) == 0 {
-		return nil
-	}
-
-	query := NewQuery(
-		qm.Select("`course`.`id`, `course`.`name`, `course`.`description`, `course`.`enroll_key`, `course`.`forum_id`, `course`.`created_at`, `course`.`updated_at`, `course`.`deleted_at`, `a`.`certificate_id`"),
-		qm.From("`course`"),
-		qm.InnerJoin("`course_requires_certificate` as `a` on `course`.`id` = `a`.`course_id`"),
-		qm.WhereIn("`a`.`certificate_id` in ?", args...),
-		qmhelper.WhereIsNull("`course`.`deleted_at`"),
-	)
-	if mods != nil {
-		mods.Apply(query)
-	}
-
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load course")
-	}
-
-	var resultSlice []*Course
-
-	var localJoinCols []string
-	for results.Next() {
-		one := new(Course)
-		var localJoinCol string
-
-		err = results.Scan(&one.ID, &one.Name, &one.Description, &one.EnrollKey, &one.ForumID, &one.CreatedAt, &one.UpdatedAt, &one.DeletedAt, &localJoinCol)
-		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for course")
-		}
-		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice course")
-		}
-
-		resultSlice = append(resultSlice, one)
-		localJoinCols = append(localJoinCols, localJoinCol)
-	}
-
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on course")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for course")
-	}
-
-	if len(courseAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
-				return err
-			}
-		}
-	}
-	if singular {
-		object.R.Courses = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &courseR{}
-			}
-			foreign.R.Certificates = append(foreign.R.Certificates, object)
-		}
-		return nil
-	}
-
-	for i, foreign := range resultSlice {
-		localJoinCol := localJoinCols[i]
-		for _, local := range slice {
-			if local.ID == localJoinCol {
-				local.R.Courses = append(local.R.Courses, foreign)
-				if foreign.R == nil {
-					foreign.R = &courseR{}
-				}
-				foreign.R.Certificates = append(foreign.R.Certificates, local)
-				break
-			}
-		}
-	}
-
-	return nil
-}
-
 // SetLinkedCourse of the certificate to the related item.
 // Sets o.R.LinkedCourse to related.
 // Adds o to related.R.LinkedCourseCertificates.
@@ -1141,151 +1000,6 @@ func (o *Certificate) SetUser(ctx context.Context, exec boil.ContextExecutor, in
 	return nil
 }
 
-// AddCourses adds the given related objects to the existing relationships
-// of the certificate, optionally inserting them as new records.
-// Appends related to o.R.Courses.
-// Sets related.R.Certificates appropriately.
-func (o *Certificate) AddCourses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Course) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		}
-	}
-
-	for _, rel := range related {
-		query := "insert into `course_requires_certificate` (`certificate_id`, `course_id`) values (?, ?)"
-		values := []interface{}{o.ID, rel.ID}
-
-		if boil.IsDebug(ctx) {
-			writer := boil.DebugWriterFrom(ctx)
-			fmt.Fprintln(writer, query)
-			fmt.Fprintln(writer, values)
-		}
-		_, err = exec.ExecContext(ctx, query, values...)
-		if err != nil {
-			return errors.Wrap(err, "failed to insert into join table")
-		}
-	}
-	if o.R == nil {
-		o.R = &certificateR{
-			Courses: related,
-		}
-	} else {
-		o.R.Courses = append(o.R.Courses, related...)
-	}
-
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &courseR{
-				Certificates: CertificateSlice{o},
-			}
-		} else {
-			rel.R.Certificates = append(rel.R.Certificates, o)
-		}
-	}
-	return nil
-}
-
-// SetCourses removes all previously related items of the
-// certificate replacing them completely with the passed
-// in related items, optionally inserting them as new records.
-// Sets o.R.Certificates's Courses accordingly.
-// Replaces o.R.Courses with related.
-// Sets related.R.Certificates's Courses accordingly.
-func (o *Certificate) SetCourses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Course) error {
-	query := "delete from `course_requires_certificate` where `certificate_id` = ?"
-	values := []interface{}{o.ID}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err := exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
-
-	removeCoursesFromCertificatesSlice(o, related)
-	if o.R != nil {
-		o.R.Courses = nil
-	}
-
-	return o.AddCourses(ctx, exec, insert, related...)
-}
-
-// RemoveCourses relationships from objects passed in.
-// Removes related items from R.Courses (uses pointer comparison, removal does not keep order)
-// Sets related.R.Certificates.
-func (o *Certificate) RemoveCourses(ctx context.Context, exec boil.ContextExecutor, related ...*Course) error {
-	if len(related) == 0 {
-		return nil
-	}
-
-	var err error
-	query := fmt.Sprintf(
-		"delete from `course_requires_certificate` where `certificate_id` = ? and `course_id` in (%s)",
-		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
-	)
-	values := []interface{}{o.ID}
-	for _, rel := range related {
-		values = append(values, rel.ID)
-	}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err = exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
-	removeCoursesFromCertificatesSlice(o, related)
-	if o.R == nil {
-		return nil
-	}
-
-	for _, rel := range related {
-		for i, ri := range o.R.Courses {
-			if rel != ri {
-				continue
-			}
-
-			ln := len(o.R.Courses)
-			if ln > 1 && i < ln-1 {
-				o.R.Courses[i] = o.R.Courses[ln-1]
-			}
-			o.R.Courses = o.R.Courses[:ln-1]
-			break
-		}
-	}
-
-	return nil
-}
-
-func removeCoursesFromCertificatesSlice(o *Certificate, related []*Course) {
-	for _, rel := range related {
-		if rel.R == nil {
-			continue
-		}
-		for i, ri := range rel.R.Certificates {
-			if o.ID != ri.ID {
-				continue
-			}
-
-			ln := len(rel.R.Certificates)
-			if ln > 1 && i < ln-1 {
-				rel.R.Certificates[i] = rel.R.Certificates[ln-1]
-			}
-			rel.R.Certificates = rel.R.Certificates[:ln-1]
-			break
-		}
-	}
-}
-
 // Certificates retrieves all the records using an executor.
 func Certificates(mods ...qm.QueryMod) certificateQuery {
 	mods = append(mods, qm.From("`certificate`"), qmhelper.WhereIsNull("`certificate`.`deleted_at`"))
@@ -106,7 +106,8 @@ var CourseRels = struct {
 	Appointments             string
 	LinkedCourseCertificates string
 	CourseHasFiles           string
-	Certificates             string
+	RequiredCourseCourses    string
+	Courses                  string
 	Directories              string
 	Exams                    string
 	FieldOfStudyHasCourses   string
@@ -117,7 +118,8 @@ var CourseRels = struct {
 	Appointments:             "Appointments",
 	LinkedCourseCertificates: "LinkedCourseCertificates",
 	CourseHasFiles:           "CourseHasFiles",
-	Certificates:             "Certificates",
+	RequiredCourseCourses:    "RequiredCourseCourses",
+	Courses:                  "Courses",
 	Directories:              "Directories",
 	Exams:                    "Exams",
 	FieldOfStudyHasCourses:   "FieldOfStudyHasCourses",
@@ -131,7 +133,8 @@ type courseR struct {
 	Appointments             AppointmentSlice           `boil:"Appointments" json:"Appointments" toml:"Appointments" yaml:"Appointments"`
 	LinkedCourseCertificates CertificateSlice           `boil:"LinkedCourseCertificates" json:"LinkedCourseCertificates" toml:"LinkedCourseCertificates" yaml:"LinkedCourseCertificates"`
 	CourseHasFiles           CourseHasFileSlice         `boil:"CourseHasFiles" json:"CourseHasFiles" toml:"CourseHasFiles" yaml:"CourseHasFiles"`
-	Certificates             CertificateSlice           `boil:"Certificates" json:"Certificates" toml:"Certificates" yaml:"Certificates"`
+	RequiredCourseCourses    CourseSlice                `boil:"RequiredCourseCourses" json:"RequiredCourseCourses" toml:"RequiredCourseCourses" yaml:"RequiredCourseCourses"`
+	Courses                  CourseSlice                `boil:"Courses" json:"Courses" toml:"Courses" yaml:"Courses"`
 	Directories              DirectorySlice             `boil:"Directories" json:"Directories" toml:"Directories" yaml:"Directories"`
 	Exams                    ExamSlice                  `boil:"Exams" json:"Exams" toml:"Exams" yaml:"Exams"`
 	FieldOfStudyHasCourses   FieldOfStudyHasCourseSlice `boil:"FieldOfStudyHasCourses" json:"FieldOfStudyHasCourses" toml:"FieldOfStudyHasCourses" yaml:"FieldOfStudyHasCourses"`
@@ -172,11 +175,18 @@ func (r *courseR) GetCourseHasFiles() CourseHasFileSlice {
 	return r.CourseHasFiles
 }
 
-func (r *courseR) GetCertificates() CertificateSlice {
+func (r *courseR) GetRequiredCourseCourses() CourseSlice {
 	if r == nil {
 		return nil
 	}
-	return r.Certificates
+	return r.RequiredCourseCourses
+}
+
+func (r *courseR) GetCourses() CourseSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Courses
 }
 
 func (r *courseR) GetDirectories() DirectorySlice {
@@ -556,19 +566,34 @@ func (o *Course) CourseHasFiles(mods ...qm.QueryMod) courseHasFileQuery {
 	return CourseHasFiles(queryMods...)
 }
 
-// Certificates retrieves all the certificate's Certificates with an executor.
-func (o *Course) Certificates(mods ...qm.QueryMod) certificateQuery {
+// RequiredCourseCourses retrieves all the course's Courses with an executor via id column.
+func (o *Course) RequiredCourseCourses(mods ...qm.QueryMod) courseQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.InnerJoin("`course_requires_certificate` on `certificate`.`id` = `course_requires_certificate`.`certificate_id`"),
+		qm.InnerJoin("`course_requires_certificate` on `course`.`id` = `course_requires_certificate`.`required_course_id`"),
 		qm.Where("`course_requires_certificate`.`course_id`=?", o.ID),
 	)
 
-	return Certificates(queryMods...)
+	return Courses(queryMods...)
+}
+
+// Courses retrieves all the course's Courses with an executor.
+func (o *Course) Courses(mods ...qm.QueryMod) courseQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("`course_requires_certificate` on `course`.`id` = `course_requires_certificate`.`course_id`"),
+		qm.Where("`course_requires_certificate`.`required_course_id`=?", o.ID),
+	)
+
+	return Courses(queryMods...)
 }
 
 // Directories retrieves all the directory's Directories with an executor.
@@ -1043,9 +1068,9 @@ func (courseL) LoadCourseHasFiles(ctx context.Context, e boil.ContextExecutor, s
 	return nil
 }
 
-// LoadCertificates allows an eager lookup of values, cached into the
+// LoadRequiredCourseCourses allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (courseL) LoadCertificates(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCourse interface{}, mods queries.Applicator) error {
+func (courseL) LoadRequiredCourseCourses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCourse interface{}, mods queries.Applicator) error {
 	var slice []*Course
 	var object *Course
 
@@ -1083,11 +1108,11 @@ func (courseL) LoadCertificates(ctx context.Context, e boil.ContextExecutor, sin
 	}
 
 	query := NewQuery(
-		qm.Select("`certificate`.`id`, `certificate`.`user_id`, `certificate`.`linked_course_id`, `certificate`.`exam_id`, `certificate`.`created_at`, `certificate`.`updated_at`, `certificate`.`deleted_at`, `a`.`course_id`"),
-		qm.From("`certificate`"),
-		qm.InnerJoin("`course_requires_certificate` as `a` on `certificate`.`id` = `a`.`certificate_id`"),
+		qm.Select("`course`.`id`, `course`.`name`, `course`.`description`, `course`.`enroll_key`, `course`.`forum_id`, `course`.`created_at`, `course`.`updated_at`, `course`.`deleted_at`, `a`.`course_id`"),
+		qm.From("`course`"),
+		qm.InnerJoin("`course_requires_certificate` as `a` on `course`.`id` = `a`.`required_course_id`"),
 		qm.WhereIn("`a`.`course_id` in ?", args...),
-		qmhelper.WhereIsNull("`certificate`.`deleted_at`"),
+		qmhelper.WhereIsNull("`course`.`deleted_at`"),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1095,22 +1120,22 @@ func (courseL) LoadCertificates(ctx context.Context, e boil.ContextExecutor, sin
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load certificate")
+		return errors.Wrap(err, "failed to eager load course")
 	}
 
-	var resultSlice []*Certificate
+	var resultSlice []*Course
 
 	var localJoinCols []int
 	for results.Next() {
-		one := new(Certificate)
+		one := new(Course)
 		var localJoinCol int
 
-		err = results.Scan(&one.ID, &one.UserID, &one.LinkedCourseID, &one.ExamID, &one.CreatedAt, &one.UpdatedAt, &one.DeletedAt, &localJoinCol)
+		err = results.Scan(&one.ID, &one.Name, &one.Description, &one.EnrollKey, &one.ForumID, &one.CreatedAt, &one.UpdatedAt, &one.DeletedAt, &localJoinCol)
 		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for certificate")
+			return errors.Wrap(err, "failed to scan eager loaded results for course")
 		}
 		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice certificate")
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice course")
 		}
 
 		resultSlice = append(resultSlice, one)
@@ -1118,13 +1143,13 @@ func (courseL) LoadCertificates(ctx context.Context, e boil.ContextExecutor, sin
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on certificate")
+		return errors.Wrap(err, "failed to close results in eager load on course")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for certificate")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for course")
 	}
 
-	if len(certificateAfterSelectHooks) != 0 {
+	if len(courseAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1132,10 +1157,10 @@ func (courseL) LoadCertificates(ctx context.Context, e boil.ContextExecutor, sin
 		}
 	}
 	if singular {
-		object.R.Certificates = resultSlice
+		object.R.RequiredCourseCourses = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &certificateR{}
+				foreign.R = &courseR{}
 			}
 			foreign.R.Courses = append(foreign.R.Courses, object)
 		}
@@ -1146,9 +1171,9 @@ func (courseL) LoadCertificates(ctx context.Context, e boil.ContextExecutor, sin
 		localJoinCol := localJoinCols[i]
 		for _, local := range slice {
 			if local.ID == localJoinCol {
-				local.R.Certificates = append(local.R.Certificates, foreign)
+				local.R.RequiredCourseCourses = append(local.R.RequiredCourseCourses, foreign)
 				if foreign.R == nil {
-					foreign.R = &certificateR{}
+					foreign.R = &courseR{}
 				}
 				foreign.R.Courses = append(foreign.R.Courses, local)
 				break
@@ -1159,6 +1184,122 @@ func (courseL) LoadCertificates(ctx context.Context, e boil.ContextExecutor, sin
 	return nil
 }
 
+// LoadCourses allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (courseL) LoadCourses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCourse interface{}, mods queries.Applicator) error {
+	var slice []*Course
+	var object *Course
+
+	if singular {
+		object = maybeCourse.(*Course)
+	} else {
+		slice = *maybeCourse.(*[]*Course)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &courseR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &courseR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.Select("`course`.`id`, `course`.`name`, `course`.`description`, `course`.`enroll_key`, `course`.`forum_id`, `course`.`created_at`, `course`.`updated_at`, `course`.`deleted_at`, `a`.`required_course_id`"),
+		qm.From("`course`"),
+		qm.InnerJoin("`course_requires_certificate` as `a` on `course`.`id` = `a`.`course_id`"),
+		qm.WhereIn("`a`.`required_course_id` in ?", args...),
+		qmhelper.WhereIsNull("`course`.`deleted_at`"),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load course")
+	}
+
+	var resultSlice []*Course
+
+	var localJoinCols []int
+	for results.Next() {
+		one := new(Course)
+		var localJoinCol int
+
+		err = results.Scan(&one.ID, &one.Name, &one.Description, &one.EnrollKey, &one.ForumID, &one.CreatedAt, &one.UpdatedAt, &one.DeletedAt, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for course")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice course")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on course")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for course")
+	}
+
+	if len(courseAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Courses = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &courseR{}
+			}
+			foreign.R.RequiredCourseCourses = append(foreign.R.RequiredCourseCourses, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Courses = append(local.R.Courses, foreign)
+				if foreign.R == nil {
+					foreign.R = &courseR{}
+				}
+				foreign.R.RequiredCourseCourses = append(foreign.R.RequiredCourseCourses, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadDirectories allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (courseL) LoadDirectories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCourse interface{}, mods queries.Applicator) error {
@@ -1859,11 +2000,11 @@ func (o *Course) AddCourseHasFiles(ctx context.Context, exec boil.ContextExecuto
 	return nil
 }
 
-// AddCertificates adds the given related objects to the existing relationships
+// AddRequiredCourseCourses adds the given related objects to the existing relationships
 // of the course, optionally inserting them as new records.
-// Appends related to o.R.Certificates.
+// Appends related to o.R.RequiredCourseCourses.
 // Sets related.R.Courses appropriately.
-func (o *Course) AddCertificates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Certificate) error {
+func (o *Course) AddRequiredCourseCourses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Course) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1874,7 +2015,7 @@ func (o *Course) AddCertificates(ctx context.Context, exec boil.ContextExecutor,
 	}
 
 	for _, rel := range related {
-		query := "insert into `course_requires_certificate` (`course_id`, `certificate_id`) values (?, ?)"
+		query := "insert into `course_requires_certificate` (`course_id`, `required_course_id`) values (?, ?)"
 		values := []interface{}{o.ID, rel.ID}
 
 		if boil.IsDebug(ctx) {
@@ -1889,15 +2030,15 @@ func (o *Course) AddCertificates(ctx context.Context, exec boil.ContextExecutor,
 	}
 	if o.R == nil {
 		o.R = &courseR{
-			Certificates: related,
+			RequiredCourseCourses: related,
 		}
 	} else {
-		o.R.Certificates = append(o.R.Certificates, related...)
+		o.R.RequiredCourseCourses = append(o.R.RequiredCourseCourses, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &certificateR{
+			rel.R = &courseR{
 				Courses: CourseSlice{o},
 			}
 		} else {
@@ -1907,13 +2048,13 @@ func (o *Course) AddCertificates(ctx context.Context, exec boil.ContextExecutor,
 	return nil
 }
 
-// SetCertificates removes all previously related items of the
+// SetRequiredCourseCourses removes all previously related items of the
 // course replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.Courses's Certificates accordingly.
-// Replaces o.R.Certificates with related.
-// Sets related.R.Courses's Certificates accordingly.
-func (o *Course) SetCertificates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Certificate) error {
+// Sets o.R.Courses's RequiredCourseCourses accordingly.
+// Replaces o.R.RequiredCourseCourses with related.
+// Sets related.R.Courses's RequiredCourseCourses accordingly.
+func (o *Course) SetRequiredCourseCourses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Course) error {
 	query := "delete from `course_requires_certificate` where `course_id` = ?"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
@@ -1926,25 +2067,25 @@ func (o *Course) SetCertificates(ctx context.Context, exec boil.ContextExecutor,
 		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	removeCertificatesFromCoursesSlice(o, related)
+	removeRequiredCourseCoursesFromCoursesSlice(o, related)
 	if o.R != nil {
-		o.R.Certificates = nil
+		o.R.RequiredCourseCourses = nil
 	}
 
-	return o.AddCertificates(ctx, exec, insert, related...)
+	return o.AddRequiredCourseCourses(ctx, exec, insert, related...)
 }
 
-// RemoveCertificates relationships from objects passed in.
-// Removes related items from R.Certificates (uses pointer comparison, removal does not keep order)
+// RemoveRequiredCourseCourses relationships from objects passed in.
+// Removes related items from R.RequiredCourseCourses (uses pointer comparison, removal does not keep order)
 // Sets related.R.Courses.
-func (o *Course) RemoveCertificates(ctx context.Context, exec boil.ContextExecutor, related ...*Certificate) error {
+func (o *Course) RemoveRequiredCourseCourses(ctx context.Context, exec boil.ContextExecutor, related ...*Course) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 	query := fmt.Sprintf(
-		"delete from `course_requires_certificate` where `course_id` = ? and `certificate_id` in (%s)",
+		"delete from `course_requires_certificate` where `course_id` = ? and `required_course_id` in (%s)",
 		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
 	)
 	values := []interface{}{o.ID}
@@ -1961,22 +2102,22 @@ func (o *Course) RemoveCertificates(ctx context.Context, exec boil.ContextExecut
 	if err != nil {
 		return errors.Wrap(err, "failed to remove relationships before set")
 	}
-	removeCertificatesFromCoursesSlice(o, related)
+	removeRequiredCourseCoursesFromCoursesSlice(o, related)
 	if o.R == nil {
 		return nil
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.Certificates {
+		for i, ri := range o.R.RequiredCourseCourses {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.Certificates)
+			ln := len(o.R.RequiredCourseCourses)
 			if ln > 1 && i < ln-1 {
-				o.R.Certificates[i] = o.R.Certificates[ln-1]
+				o.R.RequiredCourseCourses[i] = o.R.RequiredCourseCourses[ln-1]
 			}
-			o.R.Certificates = o.R.Certificates[:ln-1]
+			o.R.RequiredCourseCourses = o.R.RequiredCourseCourses[:ln-1]
 			break
 		}
 	}
@@ -1984,7 +2125,7 @@ func (o *Course) RemoveCertificates(ctx context.Context, exec boil.ContextExecut
 	return nil
 }
 
-func removeCertificatesFromCoursesSlice(o *Course, related []*Certificate) {
+func removeRequiredCourseCoursesFromCoursesSlice(o *Course, related []*Course) {
 	for _, rel := range related {
 		if rel.R == nil {
 			continue
@@ -2004,6 +2145,151 @@ func removeCertificatesFromCoursesSlice(o *Course, related []*Certificate) {
 	}
 }
 
+// AddCourses adds the given related objects to the existing relationships
+// of the course, optionally inserting them as new records.
+// Appends related to o.R.Courses.
+// Sets related.R.RequiredCourseCourses appropriately.
+func (o *Course) AddCourses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Course) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into `course_requires_certificate` (`required_course_id`, `course_id`) values (?, ?)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &courseR{
+			Courses: related,
+		}
+	} else {
+		o.R.Courses = append(o.R.Courses, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &courseR{
+				RequiredCourseCourses: CourseSlice{o},
+			}
+		} else {
+			rel.R.RequiredCourseCourses = append(rel.R.RequiredCourseCourses, o)
+		}
+	}
+	return nil
+}
+
+// SetCourses removes all previously related items of the
+// course replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.RequiredCourseCourses's Courses accordingly.
+// Replaces o.R.Courses with related.
+// Sets related.R.RequiredCourseCourses's Courses accordingly.
+func (o *Course) SetCourses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Course) error {
+	query := "delete from `course_requires_certificate` where `required_course_id` = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeCoursesFromRequiredCourseCoursesSlice(o, related)
+	if o.R != nil {
+		o.R.Courses = nil
+	}
+
+	return o.AddCourses(ctx, exec, insert, related...)
+}
+
+// RemoveCourses relationships from objects passed in.
+// Removes related items from R.Courses (uses pointer comparison, removal does not keep order)
+// Sets related.R.RequiredCourseCourses.
+func (o *Course) RemoveCourses(ctx context.Context, exec boil.ContextExecutor, related ...*Course) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from `course_requires_certificate` where `required_course_id` = ? and `course_id` in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeCoursesFromRequiredCourseCoursesSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Courses {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Courses)
+			if ln > 1 && i < ln-1 {
+				o.R.Courses[i] = o.R.Courses[ln-1]
+			}
+			o.R.Courses = o.R.Courses[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeCoursesFromRequiredCourseCoursesSlice(o *Course, related []*Course) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.RequiredCourseCourses {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.RequiredCourseCourses)
+			if ln > 1 && i < ln-1 {
+				rel.R.RequiredCourseCourses[i] = rel.R.RequiredCourseCourses[ln-1]
+			}
+			rel.R.RequiredCourseCourses = rel.R.RequiredCourseCourses[:ln-1]
+			break
+		}
+	}
+}
+
 // AddDirectories adds the given related objects to the existing relationships
 // of the course, optionally inserting them as new records.
 // Appends related to o.R.Directories.
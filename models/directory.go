@@ -590,7 +590,7 @@ func (directoryL) LoadFiles(ctx context.Context, e boil.ContextExecutor, singula
 	}
 
 	query := NewQuery(
-		qm.Select("`file`.`id`, `file`.`name`, `file`.`uri`, `file`.`local`, `file`.`uploader_id`, `file`.`created_at`, `file`.`deleted_at`, `a`.`directory_id`"),
+		qm.Select("`file`.`id`, `file`.`name`, `file`.`uri`, `file`.`local`, `file`.`uploader_id`, `file`.`created_at`, `file`.`deleted_at`, `file`.`hash`, `file`.`size`, `file`.`mime_type`, `a`.`directory_id`"),
 		qm.From("`file`"),
 		qm.InnerJoin("`directory_has_files` as `a` on `file`.`id` = `a`.`file_id`"),
 		qm.WhereIn("`a`.`directory_id` in ?", args...),
@@ -612,7 +612,7 @@ func (directoryL) LoadFiles(ctx context.Context, e boil.ContextExecutor, singula
 		one := new(File)
 		var localJoinCol int
 
-		err = results.Scan(&one.ID, &one.Name, &one.URI, &one.Local, &one.UploaderID, &one.CreatedAt, &one.DeletedAt, &localJoinCol)
+		err = results.Scan(&one.ID, &one.Name, &one.URI, &one.Local, &one.UploaderID, &one.CreatedAt, &one.DeletedAt, &one.Hash, &one.Size, &one.MimeType, &localJoinCol)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan eager loaded results for file")
 		}
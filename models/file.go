@@ -36,6 +36,12 @@ type File struct {
 	// When the file was created.
 	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	DeletedAt null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	// Blob holding the content of a local file. NULL for remote files and local files uploaded before content was deduplicated.
+	Hash null.String `boil:"hash" json:"hash,omitempty" toml:"hash" yaml:"hash,omitempty"`
+	// Size of a local file in bytes.
+	Size int64 `boil:"size" json:"size" toml:"size" yaml:"size"`
+	// MIME type of a local file. NULL for remote files and local files uploaded before it was recorded.
+	MimeType null.String `boil:"mime_type" json:"mime_type,omitempty" toml:"mime_type" yaml:"mime_type,omitempty"`
 
 	R *fileR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L fileL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -49,6 +55,9 @@ var FileColumns = struct {
 	UploaderID string
 	CreatedAt  string
 	DeletedAt  string
+	Hash       string
+	Size       string
+	MimeType   string
 }{
 	ID:         "id",
 	Name:       "name",
@@ -57,6 +66,9 @@ var FileColumns = struct {
 	UploaderID: "uploader_id",
 	CreatedAt:  "created_at",
 	DeletedAt:  "deleted_at",
+	Hash:       "hash",
+	Size:       "size",
+	MimeType:   "mime_type",
 }
 
 var FileTableColumns = struct {
@@ -67,6 +79,9 @@ var FileTableColumns = struct {
 	UploaderID string
 	CreatedAt  string
 	DeletedAt  string
+	Hash       string
+	Size       string
+	MimeType   string
 }{
 	ID:         "file.id",
 	Name:       "file.name",
@@ -75,10 +90,36 @@ var FileTableColumns = struct {
 	UploaderID: "file.uploader_id",
 	CreatedAt:  "file.created_at",
 	DeletedAt:  "file.deleted_at",
+	Hash:       "file.hash",
+	Size:       "file.size",
+	MimeType:   "file.mime_type",
 }
 
 // Generated where
 
+type whereHelperint64 struct{ field string }
+
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
 var FileWhere = struct {
 	ID         whereHelperint
 	Name       whereHelperstring
@@ -87,6 +128,9 @@ var FileWhere = struct {
 	UploaderID whereHelperint
 	CreatedAt  whereHelpertime_Time
 	DeletedAt  whereHelpernull_Time
+	Hash       whereHelpernull_String
+	Size       whereHelperint64
+	MimeType   whereHelpernull_String
 }{
 	ID:         whereHelperint{field: "`file`.`id`"},
 	Name:       whereHelperstring{field: "`file`.`name`"},
@@ -95,10 +139,14 @@ var FileWhere = struct {
 	UploaderID: whereHelperint{field: "`file`.`uploader_id`"},
 	CreatedAt:  whereHelpertime_Time{field: "`file`.`created_at`"},
 	DeletedAt:  whereHelpernull_Time{field: "`file`.`deleted_at`"},
+	Hash:       whereHelpernull_String{field: "`file`.`hash`"},
+	Size:       whereHelperint64{field: "`file`.`size`"},
+	MimeType:   whereHelpernull_String{field: "`file`.`mime_type`"},
 }
 
 // FileRels is where relationship names are stored.
 var FileRels = struct {
+	HashFileBlob        string
 	Uploader            string
 	CourseHasFiles      string
 	Directories         string
@@ -108,6 +156,7 @@ var FileRels = struct {
 	UserHasExams        string
 	UserSubmissions     string
 }{
+	HashFileBlob:        "HashFileBlob",
 	Uploader:            "Uploader",
 	CourseHasFiles:      "CourseHasFiles",
 	Directories:         "Directories",
@@ -120,6 +169,7 @@ var FileRels = struct {
 
 // fileR is where relationships are stored.
 type fileR struct {
+	HashFileBlob        *FileBlob           `boil:"HashFileBlob" json:"HashFileBlob" toml:"HashFileBlob" yaml:"HashFileBlob"`
 	Uploader            *User               `boil:"Uploader" json:"Uploader" toml:"Uploader" yaml:"Uploader"`
 	CourseHasFiles      CourseHasFileSlice  `boil:"CourseHasFiles" json:"CourseHasFiles" toml:"CourseHasFiles" yaml:"CourseHasFiles"`
 	Directories         DirectorySlice      `boil:"Directories" json:"Directories" toml:"Directories" yaml:"Directories"`
@@ -135,6 +185,13 @@ func (*fileR) NewStruct() *fileR {
 	return &fileR{}
 }
 
+func (r *fileR) GetHashFileBlob() *FileBlob {
+	if r == nil {
+		return nil
+	}
+	return r.HashFileBlob
+}
+
 func (r *fileR) GetUploader() *User {
 	if r == nil {
 		return nil
@@ -195,9 +252,9 @@ func (r *fileR) GetUserSubmissions() UserSubmissionSlice {
 type fileL struct{}
 
 var (
-	fileAllColumns            = []string{"id", "name", "uri", "local", "uploader_id", "created_at", "deleted_at"}
-	fileColumnsWithoutDefault = []string{"name", "uri", "local", "uploader_id", "deleted_at"}
-	fileColumnsWithDefault    = []string{"id", "created_at"}
+	fileAllColumns            = []string{"id", "name", "uri", "local", "uploader_id", "created_at", "deleted_at", "hash", "size", "mime_type"}
+	fileColumnsWithoutDefault = []string{"name", "uri", "local", "uploader_id", "deleted_at", "hash", "mime_type"}
+	fileColumnsWithDefault    = []string{"id", "created_at", "size"}
 	filePrimaryKeyColumns     = []string{"id"}
 	fileGeneratedColumns      = []string{}
 )
@@ -480,6 +537,17 @@ func (q fileQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// HashFileBlob pointed to by the foreign key.
+func (o *File) HashFileBlob(mods ...qm.QueryMod) fileBlobQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`hash` = ?", o.Hash),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return FileBlobs(queryMods...)
+}
+
 // Uploader pointed to by the foreign key.
 func (o *File) Uploader(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
@@ -593,6 +661,114 @@ func (o *File) UserSubmissions(mods ...qm.QueryMod) userSubmissionQuery {
 	return UserSubmissions(queryMods...)
 }
 
+// LoadHashFileBlob allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (fileL) LoadHashFileBlob(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFile interface{}, mods queries.Applicator) error {
+	var slice []*File
+	var object *File
+
+	if singular {
+		object = maybeFile.(*File)
+	} else {
+		slice = *maybeFile.(*[]*File)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &fileR{}
+		}
+		if !queries.IsNil(object.Hash) {
+			args = append(args, object.Hash)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &fileR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.Hash) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.Hash) {
+				args = append(args, obj.Hash)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`file_blob`),
+		qm.WhereIn(`file_blob.hash in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load FileBlob")
+	}
+
+	var resultSlice []*FileBlob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice FileBlob")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for file_blob")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for file_blob")
+	}
+
+	if len(fileAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.HashFileBlob = foreign
+		if foreign.R == nil {
+			foreign.R = &fileBlobR{}
+		}
+		foreign.R.HashFiles = append(foreign.R.HashFiles, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.Hash, foreign.Hash) {
+				local.R.HashFileBlob = foreign
+				if foreign.R == nil {
+					foreign.R = &fileBlobR{}
+				}
+				foreign.R.HashFiles = append(foreign.R.HashFiles, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadUploader allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (fileL) LoadUploader(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFile interface{}, mods queries.Applicator) error {
@@ -1069,7 +1245,7 @@ func (fileL) LoadSubmissions(ctx context.Context, e boil.ContextExecutor, singul
 	}
 
 	query := NewQuery(
-		qm.Select("`submission`.`id`, `submission`.`name`, `submission`.`deadline`, `submission`.`course_id`, `submission`.`max_filesize`, `submission`.`visible_from`, `submission`.`created_at`, `submission`.`updated_at`, `submission`.`graded_at`, `submission`.`deleted_at`, `a`.`file_id`"),
+		qm.Select("`submission`.`id`, `submission`.`name`, `submission`.`deadline`, `submission`.`course_id`, `submission`.`max_filesize`, `submission`.`visible_from`, `submission`.`created_at`, `submission`.`updated_at`, `submission`.`graded_at`, `submission`.`deleted_at`, `submission`.`allowed_file_types`, `a`.`file_id`"),
 		qm.From("`submission`"),
 		qm.InnerJoin("`submission_has_files` as `a` on `submission`.`id` = `a`.`submission_id`"),
 		qm.WhereIn("`a`.`file_id` in ?", args...),
@@ -1091,7 +1267,7 @@ func (fileL) LoadSubmissions(ctx context.Context, e boil.ContextExecutor, singul
 		one := new(Submission)
 		var localJoinCol int
 
-		err = results.Scan(&one.ID, &one.Name, &one.Deadline, &one.CourseID, &one.MaxFilesize, &one.VisibleFrom, &one.CreatedAt, &one.UpdatedAt, &one.GradedAt, &one.DeletedAt, &localJoinCol)
+		err = results.Scan(&one.ID, &one.Name, &one.Deadline, &one.CourseID, &one.MaxFilesize, &one.VisibleFrom, &one.CreatedAt, &one.UpdatedAt, &one.GradedAt, &one.DeletedAt, &one.AllowedFileTypes, &localJoinCol)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan eager loaded results for submission")
 		}
@@ -1459,6 +1635,86 @@ func (fileL) LoadUserSubmissions(ctx context.Context, e boil.ContextExecutor, si
 	return nil
 }
 
+// SetHashFileBlob of the file to the related item.
+// Sets o.R.HashFileBlob to related.
+// Adds o to related.R.HashFiles.
+func (o *File) SetHashFileBlob(ctx context.Context, exec boil.ContextExecutor, insert bool, related *FileBlob) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `file` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"hash"}),
+		strmangle.WhereClause("`", "`", 0, filePrimaryKeyColumns),
+	)
+	values := []interface{}{related.Hash, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.Hash, related.Hash)
+	if o.R == nil {
+		o.R = &fileR{
+			HashFileBlob: related,
+		}
+	} else {
+		o.R.HashFileBlob = related
+	}
+
+	if related.R == nil {
+		related.R = &fileBlobR{
+			HashFiles: FileSlice{o},
+		}
+	} else {
+		related.R.HashFiles = append(related.R.HashFiles, o)
+	}
+
+	return nil
+}
+
+// RemoveHashFileBlob relationship.
+// Sets o.R.HashFileBlob to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *File) RemoveHashFileBlob(ctx context.Context, exec boil.ContextExecutor, related *FileBlob) error {
+	var err error
+
+	queries.SetScanner(&o.Hash, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("hash")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.HashFileBlob = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.HashFiles {
+		if queries.Equal(o.Hash, ri.Hash) {
+			continue
+		}
+
+		ln := len(related.R.HashFiles)
+		if ln > 1 && i < ln-1 {
+			related.R.HashFiles[i] = related.R.HashFiles[ln-1]
+		}
+		related.R.HashFiles = related.R.HashFiles[:ln-1]
+		break
+	}
+	return nil
+}
+
 // SetUploader of the file to the related item.
 // Sets o.R.Uploader to related.
 // Adds o to related.R.UploaderFiles.
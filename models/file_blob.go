@@ -0,0 +1,1226 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// FileBlob is an object representing the database table.
+type FileBlob struct { // SHA-256 of the content, hex encoded.
+	Hash string `boil:"hash" json:"hash" toml:"hash" yaml:"hash"`
+	// Where the content is stored.
+	URI string `boil:"uri" json:"uri" toml:"uri" yaml:"uri"`
+	// Size of the content in bytes.
+	Size int64 `boil:"size" json:"size" toml:"size" yaml:"size"`
+	// Number of files sharing the content. The content is removed once no file refers to it anymore.
+	RefCount  int       `boil:"ref_count" json:"ref_count" toml:"ref_count" yaml:"ref_count"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	// Where the preview image of the content is stored. NULL if there is no preview (yet).
+	PreviewURI null.String `boil:"preview_uri" json:"preview_uri,omitempty" toml:"preview_uri" yaml:"preview_uri,omitempty"`
+
+	R *fileBlobR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L fileBlobL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var FileBlobColumns = struct {
+	Hash       string
+	URI        string
+	Size       string
+	RefCount   string
+	CreatedAt  string
+	PreviewURI string
+}{
+	Hash:       "hash",
+	URI:        "uri",
+	Size:       "size",
+	RefCount:   "ref_count",
+	CreatedAt:  "created_at",
+	PreviewURI: "preview_uri",
+}
+
+var FileBlobTableColumns = struct {
+	Hash       string
+	URI        string
+	Size       string
+	RefCount   string
+	CreatedAt  string
+	PreviewURI string
+}{
+	Hash:       "file_blob.hash",
+	URI:        "file_blob.uri",
+	Size:       "file_blob.size",
+	RefCount:   "file_blob.ref_count",
+	CreatedAt:  "file_blob.created_at",
+	PreviewURI: "file_blob.preview_uri",
+}
+
+// Generated where
+
+var FileBlobWhere = struct {
+	Hash       whereHelperstring
+	URI        whereHelperstring
+	Size       whereHelperint64
+	RefCount   whereHelperint
+	CreatedAt  whereHelpertime_Time
+	PreviewURI whereHelpernull_String
+}{
+	Hash:       whereHelperstring{field: "`file_blob`.`hash`"},
+	URI:        whereHelperstring{field: "`file_blob`.`uri`"},
+	Size:       whereHelperint64{field: "`file_blob`.`size`"},
+	RefCount:   whereHelperint{field: "`file_blob`.`ref_count`"},
+	CreatedAt:  whereHelpertime_Time{field: "`file_blob`.`created_at`"},
+	PreviewURI: whereHelpernull_String{field: "`file_blob`.`preview_uri`"},
+}
+
+// FileBlobRels is where relationship names are stored.
+var FileBlobRels = struct {
+	HashFiles string
+}{
+	HashFiles: "HashFiles",
+}
+
+// fileBlobR is where relationships are stored.
+type fileBlobR struct {
+	HashFiles FileSlice `boil:"HashFiles" json:"HashFiles" toml:"HashFiles" yaml:"HashFiles"`
+}
+
+// NewStruct creates a new relationship struct
+func (*fileBlobR) NewStruct() *fileBlobR {
+	return &fileBlobR{}
+}
+
+func (r *fileBlobR) GetHashFiles() FileSlice {
+	if r == nil {
+		return nil
+	}
+	return r.HashFiles
+}
+
+// fileBlobL is where Load methods for each relationship are stored.
+type fileBlobL struct{}
+
+var (
+	fileBlobAllColumns            = []string{"hash", "uri", "size", "ref_count", "created_at", "preview_uri"}
+	fileBlobColumnsWithoutDefault = []string{"hash", "uri", "size", "preview_uri"}
+	fileBlobColumnsWithDefault    = []string{"ref_count", "created_at"}
+	fileBlobPrimaryKeyColumns     = []string{"hash"}
+	fileBlobGeneratedColumns      = []string{}
+)
+
+type (
+	// FileBlobSlice is an alias for a slice of pointers to FileBlob.
+	// This should almost always be used instead of []FileBlob.
+	FileBlobSlice []*FileBlob
+	// FileBlobHook is the signature for custom FileBlob hook methods
+	FileBlobHook func(context.Context, boil.ContextExecutor, *FileBlob) error
+
+	fileBlobQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	fileBlobType                 = reflect.TypeOf(&FileBlob{})
+	fileBlobMapping              = queries.MakeStructMapping(fileBlobType)
+	fileBlobPrimaryKeyMapping, _ = queries.BindMapping(fileBlobType, fileBlobMapping, fileBlobPrimaryKeyColumns)
+	fileBlobInsertCacheMut       sync.RWMutex
+	fileBlobInsertCache          = make(map[string]insertCache)
+	fileBlobUpdateCacheMut       sync.RWMutex
+	fileBlobUpdateCache          = make(map[string]updateCache)
+	fileBlobUpsertCacheMut       sync.RWMutex
+	fileBlobUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var fileBlobAfterSelectHooks []FileBlobHook
+
+var fileBlobBeforeInsertHooks []FileBlobHook
+var fileBlobAfterInsertHooks []FileBlobHook
+
+var fileBlobBeforeUpdateHooks []FileBlobHook
+var fileBlobAfterUpdateHooks []FileBlobHook
+
+var fileBlobBeforeDeleteHooks []FileBlobHook
+var fileBlobAfterDeleteHooks []FileBlobHook
+
+var fileBlobBeforeUpsertHooks []FileBlobHook
+var fileBlobAfterUpsertHooks []FileBlobHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *FileBlob) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fileBlobAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *FileBlob) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fileBlobBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *FileBlob) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fileBlobAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *FileBlob) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fileBlobBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *FileBlob) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fileBlobAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *FileBlob) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fileBlobBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *FileBlob) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fileBlobAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *FileBlob) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fileBlobBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *FileBlob) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fileBlobAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddFileBlobHook registers your hook function for all future operations.
+func AddFileBlobHook(hookPoint boil.HookPoint, fileBlobHook FileBlobHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		fileBlobAfterSelectHooks = append(fileBlobAfterSelectHooks, fileBlobHook)
+	case boil.BeforeInsertHook:
+		fileBlobBeforeInsertHooks = append(fileBlobBeforeInsertHooks, fileBlobHook)
+	case boil.AfterInsertHook:
+		fileBlobAfterInsertHooks = append(fileBlobAfterInsertHooks, fileBlobHook)
+	case boil.BeforeUpdateHook:
+		fileBlobBeforeUpdateHooks = append(fileBlobBeforeUpdateHooks, fileBlobHook)
+	case boil.AfterUpdateHook:
+		fileBlobAfterUpdateHooks = append(fileBlobAfterUpdateHooks, fileBlobHook)
+	case boil.BeforeDeleteHook:
+		fileBlobBeforeDeleteHooks = append(fileBlobBeforeDeleteHooks, fileBlobHook)
+	case boil.AfterDeleteHook:
+		fileBlobAfterDeleteHooks = append(fileBlobAfterDeleteHooks, fileBlobHook)
+	case boil.BeforeUpsertHook:
+		fileBlobBeforeUpsertHooks = append(fileBlobBeforeUpsertHooks, fileBlobHook)
+	case boil.AfterUpsertHook:
+		fileBlobAfterUpsertHooks = append(fileBlobAfterUpsertHooks, fileBlobHook)
+	}
+}
+
+// One returns a single fileBlob record from the query.
+func (q fileBlobQuery) One(ctx context.Context, exec boil.ContextExecutor) (*FileBlob, error) {
+	o := &FileBlob{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for file_blob")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all FileBlob records from the query.
+func (q fileBlobQuery) All(ctx context.Context, exec boil.ContextExecutor) (FileBlobSlice, error) {
+	var o []*FileBlob
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to FileBlob slice")
+	}
+
+	if len(fileBlobAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all FileBlob records in the query.
+func (q fileBlobQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count file_blob rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q fileBlobQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if file_blob exists")
+	}
+
+	return count > 0, nil
+}
+
+// HashFiles retrieves all the file's Files with an executor via hash column.
+func (o *FileBlob) HashFiles(mods ...qm.QueryMod) fileQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`file`.`hash`=?", o.Hash),
+	)
+
+	return Files(queryMods...)
+}
+
+// LoadHashFiles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (fileBlobL) LoadHashFiles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFileBlob interface{}, mods queries.Applicator) error {
+	var slice []*FileBlob
+	var object *FileBlob
+
+	if singular {
+		object = maybeFileBlob.(*FileBlob)
+	} else {
+		slice = *maybeFileBlob.(*[]*FileBlob)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &fileBlobR{}
+		}
+		args = append(args, object.Hash)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &fileBlobR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.Hash) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.Hash)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`file`),
+		qm.WhereIn(`file.hash in ?`, args...),
+		qmhelper.WhereIsNull(`file.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load file")
+	}
+
+	var resultSlice []*File
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice file")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on file")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for file")
+	}
+
+	if len(fileAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.HashFiles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &fileR{}
+			}
+			foreign.R.HashFileBlob = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.Hash, foreign.Hash) {
+				local.R.HashFiles = append(local.R.HashFiles, foreign)
+				if foreign.R == nil {
+					foreign.R = &fileR{}
+				}
+				foreign.R.HashFileBlob = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddHashFiles adds the given related objects to the existing relationships
+// of the file_blob, optionally inserting them as new records.
+// Appends related to o.R.HashFiles.
+// Sets related.R.HashFileBlob appropriately.
+func (o *FileBlob) AddHashFiles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*File) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.Hash, o.Hash)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `file` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"hash"}),
+				strmangle.WhereClause("`", "`", 0, filePrimaryKeyColumns),
+			)
+			values := []interface{}{o.Hash, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.Hash, o.Hash)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &fileBlobR{
+			HashFiles: related,
+		}
+	} else {
+		o.R.HashFiles = append(o.R.HashFiles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &fileR{
+				HashFileBlob: o,
+			}
+		} else {
+			rel.R.HashFileBlob = o
+		}
+	}
+	return nil
+}
+
+// SetHashFiles removes all previously related items of the
+// file_blob replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.HashFileBlob's HashFiles accordingly.
+// Replaces o.R.HashFiles with related.
+// Sets related.R.HashFileBlob's HashFiles accordingly.
+func (o *FileBlob) SetHashFiles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*File) error {
+	query := "update `file` set `hash` = null where `hash` = ?"
+	values := []interface{}{o.Hash}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.HashFiles {
+			queries.SetScanner(&rel.Hash, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.HashFileBlob = nil
+		}
+		o.R.HashFiles = nil
+	}
+
+	return o.AddHashFiles(ctx, exec, insert, related...)
+}
+
+// RemoveHashFiles relationships from objects passed in.
+// Removes related items from R.HashFiles (uses pointer comparison, removal does not keep order)
+// Sets related.R.HashFileBlob.
+func (o *FileBlob) RemoveHashFiles(ctx context.Context, exec boil.ContextExecutor, related ...*File) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.Hash, nil)
+		if rel.R != nil {
+			rel.R.HashFileBlob = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("hash")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.HashFiles {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.HashFiles)
+			if ln > 1 && i < ln-1 {
+				o.R.HashFiles[i] = o.R.HashFiles[ln-1]
+			}
+			o.R.HashFiles = o.R.HashFiles[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// FileBlobs retrieves all the records using an executor.
+func FileBlobs(mods ...qm.QueryMod) fileBlobQuery {
+	mods = append(mods, qm.From("`file_blob`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`file_blob`.*"})
+	}
+
+	return fileBlobQuery{q}
+}
+
+// FindFileBlob retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindFileBlob(ctx context.Context, exec boil.ContextExecutor, hash string, selectCols ...string) (*FileBlob, error) {
+	fileBlobObj := &FileBlob{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `file_blob` where `hash`=?", sel,
+	)
+
+	q := queries.Raw(query, hash)
+
+	err := q.Bind(ctx, exec, fileBlobObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from file_blob")
+	}
+
+	if err = fileBlobObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return fileBlobObj, err
+	}
+
+	return fileBlobObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *FileBlob) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no file_blob provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(fileBlobColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	fileBlobInsertCacheMut.RLock()
+	cache, cached := fileBlobInsertCache[key]
+	fileBlobInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			fileBlobAllColumns,
+			fileBlobColumnsWithDefault,
+			fileBlobColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(fileBlobType, fileBlobMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(fileBlobType, fileBlobMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `file_blob` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `file_blob` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `file_blob` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, fileBlobPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into file_blob")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.Hash,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for file_blob")
+	}
+
+CacheNoHooks:
+	if !cached {
+		fileBlobInsertCacheMut.Lock()
+		fileBlobInsertCache[key] = cache
+		fileBlobInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the FileBlob.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *FileBlob) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	fileBlobUpdateCacheMut.RLock()
+	cache, cached := fileBlobUpdateCache[key]
+	fileBlobUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			fileBlobAllColumns,
+			fileBlobPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update file_blob, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `file_blob` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, fileBlobPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(fileBlobType, fileBlobMapping, append(wl, fileBlobPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update file_blob row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for file_blob")
+	}
+
+	if !cached {
+		fileBlobUpdateCacheMut.Lock()
+		fileBlobUpdateCache[key] = cache
+		fileBlobUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q fileBlobQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for file_blob")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for file_blob")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o FileBlobSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), fileBlobPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `file_blob` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, fileBlobPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in fileBlob slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all fileBlob")
+	}
+	return rowsAff, nil
+}
+
+var mySQLFileBlobUniqueColumns = []string{
+	"hash",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *FileBlob) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no file_blob provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(fileBlobColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLFileBlobUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	fileBlobUpsertCacheMut.RLock()
+	cache, cached := fileBlobUpsertCache[key]
+	fileBlobUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			fileBlobAllColumns,
+			fileBlobColumnsWithDefault,
+			fileBlobColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			fileBlobAllColumns,
+			fileBlobPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert file_blob, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`file_blob`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `file_blob` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(fileBlobType, fileBlobMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(fileBlobType, fileBlobMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for file_blob")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(fileBlobType, fileBlobMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for file_blob")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for file_blob")
+	}
+
+CacheNoHooks:
+	if !cached {
+		fileBlobUpsertCacheMut.Lock()
+		fileBlobUpsertCache[key] = cache
+		fileBlobUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single FileBlob record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *FileBlob) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no FileBlob provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), fileBlobPrimaryKeyMapping)
+	sql := "DELETE FROM `file_blob` WHERE `hash`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from file_blob")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for file_blob")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q fileBlobQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no fileBlobQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from file_blob")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for file_blob")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o FileBlobSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(fileBlobBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), fileBlobPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `file_blob` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, fileBlobPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from fileBlob slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for file_blob")
+	}
+
+	if len(fileBlobAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *FileBlob) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindFileBlob(ctx, exec, o.Hash)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *FileBlobSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := FileBlobSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), fileBlobPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `file_blob`.* FROM `file_blob` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, fileBlobPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in FileBlobSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// FileBlobExists checks if the FileBlob row exists.
+func FileBlobExists(ctx context.Context, exec boil.ContextExecutor, hash string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `file_blob` where `hash`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, hash)
+	}
+	row := exec.QueryRowContext(ctx, sql, hash)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if file_blob exists")
+	}
+
+	return exists, nil
+}
@@ -81,17 +81,20 @@ var ForumWhere = struct {
 
 // ForumRels is where relationship names are stored.
 var ForumRels = struct {
-	Courses      string
-	ForumEntries string
+	Courses             string
+	ForumEntries        string
+	ForumModerationLogs string
 }{
-	Courses:      "Courses",
-	ForumEntries: "ForumEntries",
+	Courses:             "Courses",
+	ForumEntries:        "ForumEntries",
+	ForumModerationLogs: "ForumModerationLogs",
 }
 
 // forumR is where relationships are stored.
 type forumR struct {
-	Courses      CourseSlice     `boil:"Courses" json:"Courses" toml:"Courses" yaml:"Courses"`
-	ForumEntries ForumEntrySlice `boil:"ForumEntries" json:"ForumEntries" toml:"ForumEntries" yaml:"ForumEntries"`
+	Courses             CourseSlice             `boil:"Courses" json:"Courses" toml:"Courses" yaml:"Courses"`
+	ForumEntries        ForumEntrySlice         `boil:"ForumEntries" json:"ForumEntries" toml:"ForumEntries" yaml:"ForumEntries"`
+	ForumModerationLogs ForumModerationLogSlice `boil:"ForumModerationLogs" json:"ForumModerationLogs" toml:"ForumModerationLogs" yaml:"ForumModerationLogs"`
 }
 
 // NewStruct creates a new relationship struct
@@ -113,6 +116,13 @@ func (r *forumR) GetForumEntries() ForumEntrySlice {
 	return r.ForumEntries
 }
 
+func (r *forumR) GetForumModerationLogs() ForumModerationLogSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ForumModerationLogs
+}
+
 // forumL is where Load methods for each relationship are stored.
 type forumL struct{}
 
@@ -430,6 +440,20 @@ func (o *Forum) ForumEntries(mods ...qm.QueryMod) forumEntryQuery {
 	return ForumEntries(queryMods...)
 }
 
+// ForumModerationLogs retrieves all the forum_moderation_log's ForumModerationLogs with an executor.
+func (o *Forum) ForumModerationLogs(mods ...qm.QueryMod) forumModerationLogQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`forum_moderation_log`.`forum_id`=?", o.ID),
+	)
+
+	return ForumModerationLogs(queryMods...)
+}
+
 // LoadCourses allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (forumL) LoadCourses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeForum interface{}, mods queries.Applicator) error {
@@ -628,6 +652,104 @@ func (forumL) LoadForumEntries(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
+// LoadForumModerationLogs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (forumL) LoadForumModerationLogs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeForum interface{}, mods queries.Applicator) error {
+	var slice []*Forum
+	var object *Forum
+
+	if singular {
+		object = maybeForum.(*Forum)
+	} else {
+		slice = *maybeForum.(*[]*Forum)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &forumR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &forumR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`forum_moderation_log`),
+		qm.WhereIn(`forum_moderation_log.forum_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load forum_moderation_log")
+	}
+
+	var resultSlice []*ForumModerationLog
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice forum_moderation_log")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on forum_moderation_log")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for forum_moderation_log")
+	}
+
+	if len(forumModerationLogAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ForumModerationLogs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &forumModerationLogR{}
+			}
+			foreign.R.Forum = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ForumID {
+				local.R.ForumModerationLogs = append(local.R.ForumModerationLogs, foreign)
+				if foreign.R == nil {
+					foreign.R = &forumModerationLogR{}
+				}
+				foreign.R.Forum = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // AddCourses adds the given related objects to the existing relationships
 // of the forum, optionally inserting them as new records.
 // Appends related to o.R.Courses.
@@ -734,6 +856,59 @@ func (o *Forum) AddForumEntries(ctx context.Context, exec boil.ContextExecutor,
 	return nil
 }
 
+// AddForumModerationLogs adds the given related objects to the existing relationships
+// of the forum, optionally inserting them as new records.
+// Appends related to o.R.ForumModerationLogs.
+// Sets related.R.Forum appropriately.
+func (o *Forum) AddForumModerationLogs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ForumModerationLog) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ForumID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `forum_moderation_log` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"forum_id"}),
+				strmangle.WhereClause("`", "`", 0, forumModerationLogPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ForumID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &forumR{
+			ForumModerationLogs: related,
+		}
+	} else {
+		o.R.ForumModerationLogs = append(o.R.ForumModerationLogs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &forumModerationLogR{
+				Forum: o,
+			}
+		} else {
+			rel.R.Forum = o
+		}
+	}
+	return nil
+}
+
 // Forums retrieves all the records using an executor.
 func Forums(mods ...qm.QueryMod) forumQuery {
 	mods = append(mods, qm.From("`forum`"), qmhelper.WhereIsNull("`forum`.`deleted_at`"))
@@ -39,98 +39,144 @@ type ForumEntry struct {
 	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
 	DeletedAt null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	// Whether the thread is pinned to the top of the forum. Only used for top-level entries.
+	Pinned int8 `boil:"pinned" json:"pinned" toml:"pinned" yaml:"pinned"`
+	// Whether replying to the thread is disallowed. Only used for top-level entries.
+	Locked int8 `boil:"locked" json:"locked" toml:"locked" yaml:"locked"`
+	// When the entry was hidden by a moderator.
+	HiddenAt null.Time `boil:"hidden_at" json:"hidden_at,omitempty" toml:"hidden_at" yaml:"hidden_at,omitempty"`
+	// The moderator that hid this entry.
+	HiddenByID null.Int `boil:"hidden_by_id" json:"hidden_by_id,omitempty" toml:"hidden_by_id" yaml:"hidden_by_id,omitempty"`
+	// Why the entry was hidden by a moderator.
+	HiddenReason null.String `boil:"hidden_reason" json:"hidden_reason,omitempty" toml:"hidden_reason" yaml:"hidden_reason,omitempty"`
 
 	R *forumEntryR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L forumEntryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ForumEntryColumns = struct {
-	ID        string
-	Subject   string
-	Content   string
-	InReplyTo string
-	AuthorID  string
-	ForumID   string
-	CreatedAt string
-	UpdatedAt string
-	DeletedAt string
+	ID           string
+	Subject      string
+	Content      string
+	InReplyTo    string
+	AuthorID     string
+	ForumID      string
+	CreatedAt    string
+	UpdatedAt    string
+	DeletedAt    string
+	Pinned       string
+	Locked       string
+	HiddenAt     string
+	HiddenByID   string
+	HiddenReason string
 }{
-	ID:        "id",
-	Subject:   "subject",
-	Content:   "content",
-	InReplyTo: "in_reply_to",
-	AuthorID:  "author_id",
-	ForumID:   "forum_id",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
-	DeletedAt: "deleted_at",
+	ID:           "id",
+	Subject:      "subject",
+	Content:      "content",
+	InReplyTo:    "in_reply_to",
+	AuthorID:     "author_id",
+	ForumID:      "forum_id",
+	CreatedAt:    "created_at",
+	UpdatedAt:    "updated_at",
+	DeletedAt:    "deleted_at",
+	Pinned:       "pinned",
+	Locked:       "locked",
+	HiddenAt:     "hidden_at",
+	HiddenByID:   "hidden_by_id",
+	HiddenReason: "hidden_reason",
 }
 
 var ForumEntryTableColumns = struct {
-	ID        string
-	Subject   string
-	Content   string
-	InReplyTo string
-	AuthorID  string
-	ForumID   string
-	CreatedAt string
-	UpdatedAt string
-	DeletedAt string
+	ID           string
+	Subject      string
+	Content      string
+	InReplyTo    string
+	AuthorID     string
+	ForumID      string
+	CreatedAt    string
+	UpdatedAt    string
+	DeletedAt    string
+	Pinned       string
+	Locked       string
+	HiddenAt     string
+	HiddenByID   string
+	HiddenReason string
 }{
-	ID:        "forum_entry.id",
-	Subject:   "forum_entry.subject",
-	Content:   "forum_entry.content",
-	InReplyTo: "forum_entry.in_reply_to",
-	AuthorID:  "forum_entry.author_id",
-	ForumID:   "forum_entry.forum_id",
-	CreatedAt: "forum_entry.created_at",
-	UpdatedAt: "forum_entry.updated_at",
-	DeletedAt: "forum_entry.deleted_at",
+	ID:           "forum_entry.id",
+	Subject:      "forum_entry.subject",
+	Content:      "forum_entry.content",
+	InReplyTo:    "forum_entry.in_reply_to",
+	AuthorID:     "forum_entry.author_id",
+	ForumID:      "forum_entry.forum_id",
+	CreatedAt:    "forum_entry.created_at",
+	UpdatedAt:    "forum_entry.updated_at",
+	DeletedAt:    "forum_entry.deleted_at",
+	Pinned:       "forum_entry.pinned",
+	Locked:       "forum_entry.locked",
+	HiddenAt:     "forum_entry.hidden_at",
+	HiddenByID:   "forum_entry.hidden_by_id",
+	HiddenReason: "forum_entry.hidden_reason",
 }
 
 // Generated where
 
 var ForumEntryWhere = struct {
-	ID        whereHelperint
-	Subject   whereHelperstring
-	Content   whereHelperstring
-	InReplyTo whereHelpernull_Int
-	AuthorID  whereHelperint
-	ForumID   whereHelperint
-	CreatedAt whereHelpertime_Time
-	UpdatedAt whereHelpernull_Time
-	DeletedAt whereHelpernull_Time
+	ID           whereHelperint
+	Subject      whereHelperstring
+	Content      whereHelperstring
+	InReplyTo    whereHelpernull_Int
+	AuthorID     whereHelperint
+	ForumID      whereHelperint
+	CreatedAt    whereHelpertime_Time
+	UpdatedAt    whereHelpernull_Time
+	DeletedAt    whereHelpernull_Time
+	Pinned       whereHelperint8
+	Locked       whereHelperint8
+	HiddenAt     whereHelpernull_Time
+	HiddenByID   whereHelpernull_Int
+	HiddenReason whereHelpernull_String
 }{
-	ID:        whereHelperint{field: "`forum_entry`.`id`"},
-	Subject:   whereHelperstring{field: "`forum_entry`.`subject`"},
-	Content:   whereHelperstring{field: "`forum_entry`.`content`"},
-	InReplyTo: whereHelpernull_Int{field: "`forum_entry`.`in_reply_to`"},
-	AuthorID:  whereHelperint{field: "`forum_entry`.`author_id`"},
-	ForumID:   whereHelperint{field: "`forum_entry`.`forum_id`"},
-	CreatedAt: whereHelpertime_Time{field: "`forum_entry`.`created_at`"},
-	UpdatedAt: whereHelpernull_Time{field: "`forum_entry`.`updated_at`"},
-	DeletedAt: whereHelpernull_Time{field: "`forum_entry`.`deleted_at`"},
+	ID:           whereHelperint{field: "`forum_entry`.`id`"},
+	Subject:      whereHelperstring{field: "`forum_entry`.`subject`"},
+	Content:      whereHelperstring{field: "`forum_entry`.`content`"},
+	InReplyTo:    whereHelpernull_Int{field: "`forum_entry`.`in_reply_to`"},
+	AuthorID:     whereHelperint{field: "`forum_entry`.`author_id`"},
+	ForumID:      whereHelperint{field: "`forum_entry`.`forum_id`"},
+	CreatedAt:    whereHelpertime_Time{field: "`forum_entry`.`created_at`"},
+	UpdatedAt:    whereHelpernull_Time{field: "`forum_entry`.`updated_at`"},
+	DeletedAt:    whereHelpernull_Time{field: "`forum_entry`.`deleted_at`"},
+	Pinned:       whereHelperint8{field: "`forum_entry`.`pinned`"},
+	Locked:       whereHelperint8{field: "`forum_entry`.`locked`"},
+	HiddenAt:     whereHelpernull_Time{field: "`forum_entry`.`hidden_at`"},
+	HiddenByID:   whereHelpernull_Int{field: "`forum_entry`.`hidden_by_id`"},
+	HiddenReason: whereHelpernull_String{field: "`forum_entry`.`hidden_reason`"},
 }
 
 // ForumEntryRels is where relationship names are stored.
 var ForumEntryRels = struct {
-	Forum                 string
-	InReplyToForumEntry   string
-	Author                string
-	InReplyToForumEntries string
+	Forum                    string
+	InReplyToForumEntry      string
+	Author                   string
+	HiddenBy                 string
+	InReplyToForumEntries    string
+	EntryForumModerationLogs string
 }{
-	Forum:                 "Forum",
-	InReplyToForumEntry:   "InReplyToForumEntry",
-	Author:                "Author",
-	InReplyToForumEntries: "InReplyToForumEntries",
+	Forum:                    "Forum",
+	InReplyToForumEntry:      "InReplyToForumEntry",
+	Author:                   "Author",
+	HiddenBy:                 "HiddenBy",
+	InReplyToForumEntries:    "InReplyToForumEntries",
+	EntryForumModerationLogs: "EntryForumModerationLogs",
 }
 
 // forumEntryR is where relationships are stored.
 type forumEntryR struct {
-	Forum                 *Forum          `boil:"Forum" json:"Forum" toml:"Forum" yaml:"Forum"`
-	InReplyToForumEntry   *ForumEntry     `boil:"InReplyToForumEntry" json:"InReplyToForumEntry" toml:"InReplyToForumEntry" yaml:"InReplyToForumEntry"`
-	Author                *User           `boil:"Author" json:"Author" toml:"Author" yaml:"Author"`
-	InReplyToForumEntries ForumEntrySlice `boil:"InReplyToForumEntries" json:"InReplyToForumEntries" toml:"InReplyToForumEntries" yaml:"InReplyToForumEntries"`
+	Forum                    *Forum                  `boil:"Forum" json:"Forum" toml:"Forum" yaml:"Forum"`
+	InReplyToForumEntry      *ForumEntry             `boil:"InReplyToForumEntry" json:"InReplyToForumEntry" toml:"InReplyToForumEntry" yaml:"InReplyToForumEntry"`
+	Author                   *User                   `boil:"Author" json:"Author" toml:"Author" yaml:"Author"`
+	HiddenBy                 *User                   `boil:"HiddenBy" json:"HiddenBy" toml:"HiddenBy" yaml:"HiddenBy"`
+	InReplyToForumEntries    ForumEntrySlice         `boil:"InReplyToForumEntries" json:"InReplyToForumEntries" toml:"InReplyToForumEntries" yaml:"InReplyToForumEntries"`
+	EntryForumModerationLogs ForumModerationLogSlice `boil:"EntryForumModerationLogs" json:"EntryForumModerationLogs" toml:"EntryForumModerationLogs" yaml:"EntryForumModerationLogs"`
 }
 
 // NewStruct creates a new relationship struct
@@ -159,6 +205,13 @@ func (r *forumEntryR) GetAuthor() *User {
 	return r.Author
 }
 
+func (r *forumEntryR) GetHiddenBy() *User {
+	if r == nil {
+		return nil
+	}
+	return r.HiddenBy
+}
+
 func (r *forumEntryR) GetInReplyToForumEntries() ForumEntrySlice {
 	if r == nil {
 		return nil
@@ -166,13 +219,20 @@ func (r *forumEntryR) GetInReplyToForumEntries() ForumEntrySlice {
 	return r.InReplyToForumEntries
 }
 
+func (r *forumEntryR) GetEntryForumModerationLogs() ForumModerationLogSlice {
+	if r == nil {
+		return nil
+	}
+	return r.EntryForumModerationLogs
+}
+
 // forumEntryL is where Load methods for each relationship are stored.
 type forumEntryL struct{}
 
 var (
-	forumEntryAllColumns            = []string{"id", "subject", "content", "in_reply_to", "author_id", "forum_id", "created_at", "updated_at", "deleted_at"}
-	forumEntryColumnsWithoutDefault = []string{"subject", "content", "in_reply_to", "author_id", "forum_id", "updated_at", "deleted_at"}
-	forumEntryColumnsWithDefault    = []string{"id", "created_at"}
+	forumEntryAllColumns            = []string{"id", "subject", "content", "in_reply_to", "author_id", "forum_id", "created_at", "updated_at", "deleted_at", "pinned", "locked", "hidden_at", "hidden_by_id", "hidden_reason"}
+	forumEntryColumnsWithoutDefault = []string{"subject", "content", "in_reply_to", "author_id", "forum_id", "updated_at", "deleted_at", "hidden_at", "hidden_by_id", "hidden_reason"}
+	forumEntryColumnsWithDefault    = []string{"id", "created_at", "pinned", "locked"}
 	forumEntryPrimaryKeyColumns     = []string{"id"}
 	forumEntryGeneratedColumns      = []string{}
 )
@@ -488,6 +548,17 @@ func (o *ForumEntry) Author(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// HiddenBy pointed to by the foreign key.
+func (o *ForumEntry) HiddenBy(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.HiddenByID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
 // InReplyToForumEntries retrieves all the forum_entry's ForumEntries with an executor via in_reply_to column.
 func (o *ForumEntry) InReplyToForumEntries(mods ...qm.QueryMod) forumEntryQuery {
 	var queryMods []qm.QueryMod
@@ -502,6 +573,20 @@ func (o *ForumEntry) InReplyToForumEntries(mods ...qm.QueryMod) forumEntryQuery
 	return ForumEntries(queryMods...)
 }
 
+// EntryForumModerationLogs retrieves all the forum_moderation_log's ForumModerationLogs with an executor via entry_id column.
+func (o *ForumEntry) EntryForumModerationLogs(mods ...qm.QueryMod) forumModerationLogQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`forum_moderation_log`.`entry_id`=?", o.ID),
+	)
+
+	return ForumModerationLogs(queryMods...)
+}
+
 // LoadForum allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (forumEntryL) LoadForum(ctx context.Context, e boil.ContextExecutor, singular bool, maybeForumEntry interface{}, mods queries.Applicator) error {
@@ -821,6 +906,115 @@ func (forumEntryL) LoadAuthor(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
+// LoadHiddenBy allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (forumEntryL) LoadHiddenBy(ctx context.Context, e boil.ContextExecutor, singular bool, maybeForumEntry interface{}, mods queries.Applicator) error {
+	var slice []*ForumEntry
+	var object *ForumEntry
+
+	if singular {
+		object = maybeForumEntry.(*ForumEntry)
+	} else {
+		slice = *maybeForumEntry.(*[]*ForumEntry)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &forumEntryR{}
+		}
+		if !queries.IsNil(object.HiddenByID) {
+			args = append(args, object.HiddenByID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &forumEntryR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.HiddenByID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.HiddenByID) {
+				args = append(args, obj.HiddenByID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+		qmhelper.WhereIsNull(`user.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(forumEntryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.HiddenBy = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.HiddenByForumEntries = append(foreign.R.HiddenByForumEntries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.HiddenByID, foreign.ID) {
+				local.R.HiddenBy = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.HiddenByForumEntries = append(foreign.R.HiddenByForumEntries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadInReplyToForumEntries allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (forumEntryL) LoadInReplyToForumEntries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeForumEntry interface{}, mods queries.Applicator) error {
@@ -920,6 +1114,104 @@ func (forumEntryL) LoadInReplyToForumEntries(ctx context.Context, e boil.Context
 	return nil
 }
 
+// LoadEntryForumModerationLogs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (forumEntryL) LoadEntryForumModerationLogs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeForumEntry interface{}, mods queries.Applicator) error {
+	var slice []*ForumEntry
+	var object *ForumEntry
+
+	if singular {
+		object = maybeForumEntry.(*ForumEntry)
+	} else {
+		slice = *maybeForumEntry.(*[]*ForumEntry)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &forumEntryR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &forumEntryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`forum_moderation_log`),
+		qm.WhereIn(`forum_moderation_log.entry_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load forum_moderation_log")
+	}
+
+	var resultSlice []*ForumModerationLog
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice forum_moderation_log")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on forum_moderation_log")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for forum_moderation_log")
+	}
+
+	if len(forumModerationLogAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.EntryForumModerationLogs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &forumModerationLogR{}
+			}
+			foreign.R.Entry = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.EntryID {
+				local.R.EntryForumModerationLogs = append(local.R.EntryForumModerationLogs, foreign)
+				if foreign.R == nil {
+					foreign.R = &forumModerationLogR{}
+				}
+				foreign.R.Entry = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetForum of the forumEntry to the related item.
 // Sets o.R.Forum to related.
 // Adds o to related.R.ForumEntries.
@@ -1094,6 +1386,86 @@ func (o *ForumEntry) SetAuthor(ctx context.Context, exec boil.ContextExecutor, i
 	return nil
 }
 
+// SetHiddenBy of the forumEntry to the related item.
+// Sets o.R.HiddenBy to related.
+// Adds o to related.R.HiddenByForumEntries.
+func (o *ForumEntry) SetHiddenBy(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `forum_entry` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"hidden_by_id"}),
+		strmangle.WhereClause("`", "`", 0, forumEntryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.HiddenByID, related.ID)
+	if o.R == nil {
+		o.R = &forumEntryR{
+			HiddenBy: related,
+		}
+	} else {
+		o.R.HiddenBy = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			HiddenByForumEntries: ForumEntrySlice{o},
+		}
+	} else {
+		related.R.HiddenByForumEntries = append(related.R.HiddenByForumEntries, o)
+	}
+
+	return nil
+}
+
+// RemoveHiddenBy relationship.
+// Sets o.R.HiddenBy to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *ForumEntry) RemoveHiddenBy(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.HiddenByID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("hidden_by_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.HiddenBy = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.HiddenByForumEntries {
+		if queries.Equal(o.HiddenByID, ri.HiddenByID) {
+			continue
+		}
+
+		ln := len(related.R.HiddenByForumEntries)
+		if ln > 1 && i < ln-1 {
+			related.R.HiddenByForumEntries[i] = related.R.HiddenByForumEntries[ln-1]
+		}
+		related.R.HiddenByForumEntries = related.R.HiddenByForumEntries[:ln-1]
+		break
+	}
+	return nil
+}
+
 // AddInReplyToForumEntries adds the given related objects to the existing relationships
 // of the forum_entry, optionally inserting them as new records.
 // Appends related to o.R.InReplyToForumEntries.
@@ -1221,6 +1593,59 @@ func (o *ForumEntry) RemoveInReplyToForumEntries(ctx context.Context, exec boil.
 	return nil
 }
 
+// AddEntryForumModerationLogs adds the given related objects to the existing relationships
+// of the forum_entry, optionally inserting them as new records.
+// Appends related to o.R.EntryForumModerationLogs.
+// Sets related.R.Entry appropriately.
+func (o *ForumEntry) AddEntryForumModerationLogs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ForumModerationLog) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.EntryID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `forum_moderation_log` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"entry_id"}),
+				strmangle.WhereClause("`", "`", 0, forumModerationLogPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.EntryID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &forumEntryR{
+			EntryForumModerationLogs: related,
+		}
+	} else {
+		o.R.EntryForumModerationLogs = append(o.R.EntryForumModerationLogs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &forumModerationLogR{
+				Entry: o,
+			}
+		} else {
+			rel.R.Entry = o
+		}
+	}
+	return nil
+}
+
 // ForumEntries retrieves all the records using an executor.
 func ForumEntries(mods ...qm.QueryMod) forumEntryQuery {
 	mods = append(mods, qm.From("`forum_entry`"), qmhelper.WhereIsNull("`forum_entry`.`deleted_at`"))
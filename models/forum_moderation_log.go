@@ -0,0 +1,1547 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ForumModerationLog is an object representing the database table.
+type ForumModerationLog struct {
+	ID int `boil:"id" json:"id" toml:"id" yaml:"id"`
+	// The forum the moderated entry belongs to.
+	ForumID int `boil:"forum_id" json:"forum_id" toml:"forum_id" yaml:"forum_id"`
+	// The entry that was moderated.
+	EntryID int `boil:"entry_id" json:"entry_id" toml:"entry_id" yaml:"entry_id"`
+	// The moderator that performed the action.
+	ModeratorID int `boil:"moderator_id" json:"moderator_id" toml:"moderator_id" yaml:"moderator_id"`
+	// The moderation action that was performed.
+	Action ForumModerationLogAction `boil:"action" json:"action" toml:"action" yaml:"action"`
+	// The reason given by the moderator, if any.
+	Reason null.String `boil:"reason" json:"reason,omitempty" toml:"reason" yaml:"reason,omitempty"`
+	// When the action was performed.
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *forumModerationLogR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L forumModerationLogL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ForumModerationLogColumns = struct {
+	ID          string
+	ForumID     string
+	EntryID     string
+	ModeratorID string
+	Action      string
+	Reason      string
+	CreatedAt   string
+}{
+	ID:          "id",
+	ForumID:     "forum_id",
+	EntryID:     "entry_id",
+	ModeratorID: "moderator_id",
+	Action:      "action",
+	Reason:      "reason",
+	CreatedAt:   "created_at",
+}
+
+var ForumModerationLogTableColumns = struct {
+	ID          string
+	ForumID     string
+	EntryID     string
+	ModeratorID string
+	Action      string
+	Reason      string
+	CreatedAt   string
+}{
+	ID:          "forum_moderation_log.id",
+	ForumID:     "forum_moderation_log.forum_id",
+	EntryID:     "forum_moderation_log.entry_id",
+	ModeratorID: "forum_moderation_log.moderator_id",
+	Action:      "forum_moderation_log.action",
+	Reason:      "forum_moderation_log.reason",
+	CreatedAt:   "forum_moderation_log.created_at",
+}
+
+// Generated where
+
+type whereHelperForumModerationLogAction struct{ field string }
+
+func (w whereHelperForumModerationLogAction) EQ(x ForumModerationLogAction) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperForumModerationLogAction) NEQ(x ForumModerationLogAction) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperForumModerationLogAction) LT(x ForumModerationLogAction) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperForumModerationLogAction) LTE(x ForumModerationLogAction) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperForumModerationLogAction) GT(x ForumModerationLogAction) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperForumModerationLogAction) GTE(x ForumModerationLogAction) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var ForumModerationLogWhere = struct {
+	ID          whereHelperint
+	ForumID     whereHelperint
+	EntryID     whereHelperint
+	ModeratorID whereHelperint
+	Action      whereHelperForumModerationLogAction
+	Reason      whereHelpernull_String
+	CreatedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperint{field: "`forum_moderation_log`.`id`"},
+	ForumID:     whereHelperint{field: "`forum_moderation_log`.`forum_id`"},
+	EntryID:     whereHelperint{field: "`forum_moderation_log`.`entry_id`"},
+	ModeratorID: whereHelperint{field: "`forum_moderation_log`.`moderator_id`"},
+	Action:      whereHelperForumModerationLogAction{field: "`forum_moderation_log`.`action`"},
+	Reason:      whereHelpernull_String{field: "`forum_moderation_log`.`reason`"},
+	CreatedAt:   whereHelpertime_Time{field: "`forum_moderation_log`.`created_at`"},
+}
+
+// ForumModerationLogRels is where relationship names are stored.
+var ForumModerationLogRels = struct {
+	Forum     string
+	Entry     string
+	Moderator string
+}{
+	Forum:     "Forum",
+	Entry:     "Entry",
+	Moderator: "Moderator",
+}
+
+// forumModerationLogR is where relationships are stored.
+type forumModerationLogR struct {
+	Forum     *Forum      `boil:"Forum" json:"Forum" toml:"Forum" yaml:"Forum"`
+	Entry     *ForumEntry `boil:"Entry" json:"Entry" toml:"Entry" yaml:"Entry"`
+	Moderator *User       `boil:"Moderator" json:"Moderator" toml:"Moderator" yaml:"Moderator"`
+}
+
+// NewStruct creates a new relationship struct
+func (*forumModerationLogR) NewStruct() *forumModerationLogR {
+	return &forumModerationLogR{}
+}
+
+func (r *forumModerationLogR) GetForum() *Forum {
+	if r == nil {
+		return nil
+	}
+	return r.Forum
+}
+
+func (r *forumModerationLogR) GetEntry() *ForumEntry {
+	if r == nil {
+		return nil
+	}
+	return r.Entry
+}
+
+func (r *forumModerationLogR) GetModerator() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Moderator
+}
+
+// forumModerationLogL is where Load methods for each relationship are stored.
+type forumModerationLogL struct{}
+
+var (
+	forumModerationLogAllColumns            = []string{"id", "forum_id", "entry_id", "moderator_id", "action", "reason", "created_at"}
+	forumModerationLogColumnsWithoutDefault = []string{"forum_id", "entry_id", "moderator_id", "action", "reason"}
+	forumModerationLogColumnsWithDefault    = []string{"id", "created_at"}
+	forumModerationLogPrimaryKeyColumns     = []string{"id"}
+	forumModerationLogGeneratedColumns      = []string{}
+)
+
+type (
+	// ForumModerationLogSlice is an alias for a slice of pointers to ForumModerationLog.
+	// This should almost always be used instead of []ForumModerationLog.
+	ForumModerationLogSlice []*ForumModerationLog
+	// ForumModerationLogHook is the signature for custom ForumModerationLog hook methods
+	ForumModerationLogHook func(context.Context, boil.ContextExecutor, *ForumModerationLog) error
+
+	forumModerationLogQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	forumModerationLogType                 = reflect.TypeOf(&ForumModerationLog{})
+	forumModerationLogMapping              = queries.MakeStructMapping(forumModerationLogType)
+	forumModerationLogPrimaryKeyMapping, _ = queries.BindMapping(forumModerationLogType, forumModerationLogMapping, forumModerationLogPrimaryKeyColumns)
+	forumModerationLogInsertCacheMut       sync.RWMutex
+	forumModerationLogInsertCache          = make(map[string]insertCache)
+	forumModerationLogUpdateCacheMut       sync.RWMutex
+	forumModerationLogUpdateCache          = make(map[string]updateCache)
+	forumModerationLogUpsertCacheMut       sync.RWMutex
+	forumModerationLogUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var forumModerationLogAfterSelectHooks []ForumModerationLogHook
+
+var forumModerationLogBeforeInsertHooks []ForumModerationLogHook
+var forumModerationLogAfterInsertHooks []ForumModerationLogHook
+
+var forumModerationLogBeforeUpdateHooks []ForumModerationLogHook
+var forumModerationLogAfterUpdateHooks []ForumModerationLogHook
+
+var forumModerationLogBeforeDeleteHooks []ForumModerationLogHook
+var forumModerationLogAfterDeleteHooks []ForumModerationLogHook
+
+var forumModerationLogBeforeUpsertHooks []ForumModerationLogHook
+var forumModerationLogAfterUpsertHooks []ForumModerationLogHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ForumModerationLog) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range forumModerationLogAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ForumModerationLog) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range forumModerationLogBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ForumModerationLog) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range forumModerationLogAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ForumModerationLog) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range forumModerationLogBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ForumModerationLog) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range forumModerationLogAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ForumModerationLog) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range forumModerationLogBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ForumModerationLog) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range forumModerationLogAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ForumModerationLog) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range forumModerationLogBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ForumModerationLog) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range forumModerationLogAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddForumModerationLogHook registers your hook function for all future operations.
+func AddForumModerationLogHook(hookPoint boil.HookPoint, forumModerationLogHook ForumModerationLogHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		forumModerationLogAfterSelectHooks = append(forumModerationLogAfterSelectHooks, forumModerationLogHook)
+	case boil.BeforeInsertHook:
+		forumModerationLogBeforeInsertHooks = append(forumModerationLogBeforeInsertHooks, forumModerationLogHook)
+	case boil.AfterInsertHook:
+		forumModerationLogAfterInsertHooks = append(forumModerationLogAfterInsertHooks, forumModerationLogHook)
+	case boil.BeforeUpdateHook:
+		forumModerationLogBeforeUpdateHooks = append(forumModerationLogBeforeUpdateHooks, forumModerationLogHook)
+	case boil.AfterUpdateHook:
+		forumModerationLogAfterUpdateHooks = append(forumModerationLogAfterUpdateHooks, forumModerationLogHook)
+	case boil.BeforeDeleteHook:
+		forumModerationLogBeforeDeleteHooks = append(forumModerationLogBeforeDeleteHooks, forumModerationLogHook)
+	case boil.AfterDeleteHook:
+		forumModerationLogAfterDeleteHooks = append(forumModerationLogAfterDeleteHooks, forumModerationLogHook)
+	case boil.BeforeUpsertHook:
+		forumModerationLogBeforeUpsertHooks = append(forumModerationLogBeforeUpsertHooks, forumModerationLogHook)
+	case boil.AfterUpsertHook:
+		forumModerationLogAfterUpsertHooks = append(forumModerationLogAfterUpsertHooks, forumModerationLogHook)
+	}
+}
+
+// One returns a single forumModerationLog record from the query.
+func (q forumModerationLogQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ForumModerationLog, error) {
+	o := &ForumModerationLog{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for forum_moderation_log")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ForumModerationLog records from the query.
+func (q forumModerationLogQuery) All(ctx context.Context, exec boil.ContextExecutor) (ForumModerationLogSlice, error) {
+	var o []*ForumModerationLog
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ForumModerationLog slice")
+	}
+
+	if len(forumModerationLogAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ForumModerationLog records in the query.
+func (q forumModerationLogQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count forum_moderation_log rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q forumModerationLogQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if forum_moderation_log exists")
+	}
+
+	return count > 0, nil
+}
+
+// Forum pointed to by the foreign key.
+func (o *ForumModerationLog) Forum(mods ...qm.QueryMod) forumQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ForumID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Forums(queryMods...)
+}
+
+// Entry pointed to by the foreign key.
+func (o *ForumModerationLog) Entry(mods ...qm.QueryMod) forumEntryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.EntryID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ForumEntries(queryMods...)
+}
+
+// Moderator pointed to by the foreign key.
+func (o *ForumModerationLog) Moderator(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ModeratorID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadForum allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (forumModerationLogL) LoadForum(ctx context.Context, e boil.ContextExecutor, singular bool, maybeForumModerationLog interface{}, mods queries.Applicator) error {
+	var slice []*ForumModerationLog
+	var object *ForumModerationLog
+
+	if singular {
+		object = maybeForumModerationLog.(*ForumModerationLog)
+	} else {
+		slice = *maybeForumModerationLog.(*[]*ForumModerationLog)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &forumModerationLogR{}
+		}
+		args = append(args, object.ForumID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &forumModerationLogR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ForumID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ForumID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`forum`),
+		qm.WhereIn(`forum.id in ?`, args...),
+		qmhelper.WhereIsNull(`forum.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Forum")
+	}
+
+	var resultSlice []*Forum
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Forum")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for forum")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for forum")
+	}
+
+	if len(forumModerationLogAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Forum = foreign
+		if foreign.R == nil {
+			foreign.R = &forumR{}
+		}
+		foreign.R.ForumModerationLogs = append(foreign.R.ForumModerationLogs, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ForumID == foreign.ID {
+				local.R.Forum = foreign
+				if foreign.R == nil {
+					foreign.R = &forumR{}
+				}
+				foreign.R.ForumModerationLogs = append(foreign.R.ForumModerationLogs, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadEntry allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (forumModerationLogL) LoadEntry(ctx context.Context, e boil.ContextExecutor, singular bool, maybeForumModerationLog interface{}, mods queries.Applicator) error {
+	var slice []*ForumModerationLog
+	var object *ForumModerationLog
+
+	if singular {
+		object = maybeForumModerationLog.(*ForumModerationLog)
+	} else {
+		slice = *maybeForumModerationLog.(*[]*ForumModerationLog)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &forumModerationLogR{}
+		}
+		args = append(args, object.EntryID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &forumModerationLogR{}
+			}
+
+			for _, a := range args {
+				if a == obj.EntryID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.EntryID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`forum_entry`),
+		qm.WhereIn(`forum_entry.id in ?`, args...),
+		qmhelper.WhereIsNull(`forum_entry.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ForumEntry")
+	}
+
+	var resultSlice []*ForumEntry
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ForumEntry")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for forum_entry")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for forum_entry")
+	}
+
+	if len(forumModerationLogAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Entry = foreign
+		if foreign.R == nil {
+			foreign.R = &forumEntryR{}
+		}
+		foreign.R.EntryForumModerationLogs = append(foreign.R.EntryForumModerationLogs, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.EntryID == foreign.ID {
+				local.R.Entry = foreign
+				if foreign.R == nil {
+					foreign.R = &forumEntryR{}
+				}
+				foreign.R.EntryForumModerationLogs = append(foreign.R.EntryForumModerationLogs, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadModerator allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (forumModerationLogL) LoadModerator(ctx context.Context, e boil.ContextExecutor, singular bool, maybeForumModerationLog interface{}, mods queries.Applicator) error {
+	var slice []*ForumModerationLog
+	var object *ForumModerationLog
+
+	if singular {
+		object = maybeForumModerationLog.(*ForumModerationLog)
+	} else {
+		slice = *maybeForumModerationLog.(*[]*ForumModerationLog)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &forumModerationLogR{}
+		}
+		args = append(args, object.ModeratorID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &forumModerationLogR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ModeratorID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ModeratorID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+		qmhelper.WhereIsNull(`user.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(forumModerationLogAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Moderator = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ModeratorForumModerationLogs = append(foreign.R.ModeratorForumModerationLogs, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ModeratorID == foreign.ID {
+				local.R.Moderator = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ModeratorForumModerationLogs = append(foreign.R.ModeratorForumModerationLogs, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetForum of the forumModerationLog to the related item.
+// Sets o.R.Forum to related.
+// Adds o to related.R.ForumModerationLogs.
+func (o *ForumModerationLog) SetForum(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Forum) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `forum_moderation_log` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"forum_id"}),
+		strmangle.WhereClause("`", "`", 0, forumModerationLogPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ForumID = related.ID
+	if o.R == nil {
+		o.R = &forumModerationLogR{
+			Forum: related,
+		}
+	} else {
+		o.R.Forum = related
+	}
+
+	if related.R == nil {
+		related.R = &forumR{
+			ForumModerationLogs: ForumModerationLogSlice{o},
+		}
+	} else {
+		related.R.ForumModerationLogs = append(related.R.ForumModerationLogs, o)
+	}
+
+	return nil
+}
+
+// SetEntry of the forumModerationLog to the related item.
+// Sets o.R.Entry to related.
+// Adds o to related.R.EntryForumModerationLogs.
+func (o *ForumModerationLog) SetEntry(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ForumEntry) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `forum_moderation_log` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"entry_id"}),
+		strmangle.WhereClause("`", "`", 0, forumModerationLogPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.EntryID = related.ID
+	if o.R == nil {
+		o.R = &forumModerationLogR{
+			Entry: related,
+		}
+	} else {
+		o.R.Entry = related
+	}
+
+	if related.R == nil {
+		related.R = &forumEntryR{
+			EntryForumModerationLogs: ForumModerationLogSlice{o},
+		}
+	} else {
+		related.R.EntryForumModerationLogs = append(related.R.EntryForumModerationLogs, o)
+	}
+
+	return nil
+}
+
+// SetModerator of the forumModerationLog to the related item.
+// Sets o.R.Moderator to related.
+// Adds o to related.R.ModeratorForumModerationLogs.
+func (o *ForumModerationLog) SetModerator(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `forum_moderation_log` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"moderator_id"}),
+		strmangle.WhereClause("`", "`", 0, forumModerationLogPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ModeratorID = related.ID
+	if o.R == nil {
+		o.R = &forumModerationLogR{
+			Moderator: related,
+		}
+	} else {
+		o.R.Moderator = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ModeratorForumModerationLogs: ForumModerationLogSlice{o},
+		}
+	} else {
+		related.R.ModeratorForumModerationLogs = append(related.R.ModeratorForumModerationLogs, o)
+	}
+
+	return nil
+}
+
+// ForumModerationLogs retrieves all the records using an executor.
+func ForumModerationLogs(mods ...qm.QueryMod) forumModerationLogQuery {
+	mods = append(mods, qm.From("`forum_moderation_log`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`forum_moderation_log`.*"})
+	}
+
+	return forumModerationLogQuery{q}
+}
+
+// FindForumModerationLog retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindForumModerationLog(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*ForumModerationLog, error) {
+	forumModerationLogObj := &ForumModerationLog{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `forum_moderation_log` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, forumModerationLogObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from forum_moderation_log")
+	}
+
+	if err = forumModerationLogObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return forumModerationLogObj, err
+	}
+
+	return forumModerationLogObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ForumModerationLog) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no forum_moderation_log provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(forumModerationLogColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	forumModerationLogInsertCacheMut.RLock()
+	cache, cached := forumModerationLogInsertCache[key]
+	forumModerationLogInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			forumModerationLogAllColumns,
+			forumModerationLogColumnsWithDefault,
+			forumModerationLogColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(forumModerationLogType, forumModerationLogMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(forumModerationLogType, forumModerationLogMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `forum_moderation_log` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `forum_moderation_log` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `forum_moderation_log` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, forumModerationLogPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into forum_moderation_log")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == forumModerationLogMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for forum_moderation_log")
+	}
+
+CacheNoHooks:
+	if !cached {
+		forumModerationLogInsertCacheMut.Lock()
+		forumModerationLogInsertCache[key] = cache
+		forumModerationLogInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ForumModerationLog.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ForumModerationLog) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	forumModerationLogUpdateCacheMut.RLock()
+	cache, cached := forumModerationLogUpdateCache[key]
+	forumModerationLogUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			forumModerationLogAllColumns,
+			forumModerationLogPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update forum_moderation_log, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `forum_moderation_log` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, forumModerationLogPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(forumModerationLogType, forumModerationLogMapping, append(wl, forumModerationLogPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update forum_moderation_log row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for forum_moderation_log")
+	}
+
+	if !cached {
+		forumModerationLogUpdateCacheMut.Lock()
+		forumModerationLogUpdateCache[key] = cache
+		forumModerationLogUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q forumModerationLogQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for forum_moderation_log")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for forum_moderation_log")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ForumModerationLogSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), forumModerationLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `forum_moderation_log` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, forumModerationLogPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in forumModerationLog slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all forumModerationLog")
+	}
+	return rowsAff, nil
+}
+
+var mySQLForumModerationLogUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ForumModerationLog) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no forum_moderation_log provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(forumModerationLogColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLForumModerationLogUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	forumModerationLogUpsertCacheMut.RLock()
+	cache, cached := forumModerationLogUpsertCache[key]
+	forumModerationLogUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			forumModerationLogAllColumns,
+			forumModerationLogColumnsWithDefault,
+			forumModerationLogColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			forumModerationLogAllColumns,
+			forumModerationLogPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert forum_moderation_log, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`forum_moderation_log`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `forum_moderation_log` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(forumModerationLogType, forumModerationLogMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(forumModerationLogType, forumModerationLogMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for forum_moderation_log")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == forumModerationLogMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(forumModerationLogType, forumModerationLogMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for forum_moderation_log")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for forum_moderation_log")
+	}
+
+CacheNoHooks:
+	if !cached {
+		forumModerationLogUpsertCacheMut.Lock()
+		forumModerationLogUpsertCache[key] = cache
+		forumModerationLogUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ForumModerationLog record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ForumModerationLog) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ForumModerationLog provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), forumModerationLogPrimaryKeyMapping)
+	sql := "DELETE FROM `forum_moderation_log` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from forum_moderation_log")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for forum_moderation_log")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q forumModerationLogQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no forumModerationLogQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from forum_moderation_log")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for forum_moderation_log")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ForumModerationLogSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(forumModerationLogBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), forumModerationLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `forum_moderation_log` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, forumModerationLogPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from forumModerationLog slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for forum_moderation_log")
+	}
+
+	if len(forumModerationLogAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ForumModerationLog) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindForumModerationLog(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ForumModerationLogSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ForumModerationLogSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), forumModerationLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `forum_moderation_log`.* FROM `forum_moderation_log` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, forumModerationLogPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ForumModerationLogSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ForumModerationLogExists checks if the ForumModerationLog row exists.
+func ForumModerationLogExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `forum_moderation_log` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if forum_moderation_log exists")
+	}
+
+	return exists, nil
+}
@@ -39,6 +39,8 @@ type Notification struct {
 	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
 	DeletedAt null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	// The time this notification was sent to the user by mail.
+	MailedAt null.Time `boil:"mailed_at" json:"mailed_at,omitempty" toml:"mailed_at" yaml:"mailed_at,omitempty"`
 
 	R *notificationR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L notificationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -54,6 +56,7 @@ var NotificationColumns = struct {
 	CreatedAt string
 	UpdatedAt string
 	DeletedAt string
+	MailedAt  string
 }{
 	ID:        "id",
 	Title:     "title",
@@ -64,6 +67,7 @@ var NotificationColumns = struct {
 	CreatedAt: "created_at",
 	UpdatedAt: "updated_at",
 	DeletedAt: "deleted_at",
+	MailedAt:  "mailed_at",
 }
 
 var NotificationTableColumns = struct {
@@ -76,6 +80,7 @@ var NotificationTableColumns = struct {
 	CreatedAt string
 	UpdatedAt string
 	DeletedAt string
+	MailedAt  string
 }{
 	ID:        "notification.id",
 	Title:     "notification.title",
@@ -86,6 +91,7 @@ var NotificationTableColumns = struct {
 	CreatedAt: "notification.created_at",
 	UpdatedAt: "notification.updated_at",
 	DeletedAt: "notification.deleted_at",
+	MailedAt:  "notification.mailed_at",
 }
 
 // Generated where
@@ -100,6 +106,7 @@ var NotificationWhere = struct {
 	CreatedAt whereHelpertime_Time
 	UpdatedAt whereHelpernull_Time
 	DeletedAt whereHelpernull_Time
+	MailedAt  whereHelpernull_Time
 }{
 	ID:        whereHelperint{field: "`notification`.`id`"},
 	Title:     whereHelperstring{field: "`notification`.`title`"},
@@ -110,6 +117,7 @@ var NotificationWhere = struct {
 	CreatedAt: whereHelpertime_Time{field: "`notification`.`created_at`"},
 	UpdatedAt: whereHelpernull_Time{field: "`notification`.`updated_at`"},
 	DeletedAt: whereHelpernull_Time{field: "`notification`.`deleted_at`"},
+	MailedAt:  whereHelpernull_Time{field: "`notification`.`mailed_at`"},
 }
 
 // NotificationRels is where relationship names are stored.
@@ -140,8 +148,8 @@ func (r *notificationR) GetUserTo() *User {
 type notificationL struct{}
 
 var (
-	notificationAllColumns            = []string{"id", "title", "body", "url", "user_to_id", "time_read", "created_at", "updated_at", "deleted_at"}
-	notificationColumnsWithoutDefault = []string{"title", "body", "url", "user_to_id", "time_read", "updated_at", "deleted_at"}
+	notificationAllColumns            = []string{"id", "title", "body", "url", "user_to_id", "time_read", "created_at", "updated_at", "deleted_at", "mailed_at"}
+	notificationColumnsWithoutDefault = []string{"title", "body", "url", "user_to_id", "time_read", "updated_at", "deleted_at", "mailed_at"}
 	notificationColumnsWithDefault    = []string{"id", "created_at"}
 	notificationPrimaryKeyColumns     = []string{"id"}
 	notificationGeneratedColumns      = []string{}
@@ -31,6 +31,8 @@ type Role struct {
 	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt   null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
 	DeletedAt   null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	// Number of bytes users with this role can upload in total. 0 = unlimited, NULL = use MaxUploadPerUser from the config.
+	UploadQuota null.Int64 `boil:"upload_quota" json:"upload_quota,omitempty" toml:"upload_quota" yaml:"upload_quota,omitempty"`
 
 	R *roleR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L roleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -43,6 +45,7 @@ var RoleColumns = struct {
 	CreatedAt   string
 	UpdatedAt   string
 	DeletedAt   string
+	UploadQuota string
 }{
 	ID:          "id",
 	Name:        "name",
@@ -50,6 +53,7 @@ var RoleColumns = struct {
 	CreatedAt:   "created_at",
 	UpdatedAt:   "updated_at",
 	DeletedAt:   "deleted_at",
+	UploadQuota: "upload_quota",
 }
 
 var RoleTableColumns = struct {
@@ -59,6 +63,7 @@ var RoleTableColumns = struct {
 	CreatedAt   string
 	UpdatedAt   string
 	DeletedAt   string
+	UploadQuota string
 }{
 	ID:          "role.id",
 	Name:        "role.name",
@@ -66,10 +71,35 @@ var RoleTableColumns = struct {
 	CreatedAt:   "role.created_at",
 	UpdatedAt:   "role.updated_at",
 	DeletedAt:   "role.deleted_at",
+	UploadQuota: "role.upload_quota",
 }
 
 // Generated where
 
+type whereHelpernull_Int64 struct{ field string }
+
+func (w whereHelpernull_Int64) EQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int64) NEQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int64) LT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int64) LTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int64) GT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int64) GTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Int64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
 var RoleWhere = struct {
 	ID          whereHelperint
 	Name        whereHelperstring
@@ -77,6 +107,7 @@ var RoleWhere = struct {
 	CreatedAt   whereHelpertime_Time
 	UpdatedAt   whereHelpernull_Time
 	DeletedAt   whereHelpernull_Time
+	UploadQuota whereHelpernull_Int64
 }{
 	ID:          whereHelperint{field: "`role`.`id`"},
 	Name:        whereHelperstring{field: "`role`.`name`"},
@@ -84,6 +115,7 @@ var RoleWhere = struct {
 	CreatedAt:   whereHelpertime_Time{field: "`role`.`created_at`"},
 	UpdatedAt:   whereHelpernull_Time{field: "`role`.`updated_at`"},
 	DeletedAt:   whereHelpernull_Time{field: "`role`.`deleted_at`"},
+	UploadQuota: whereHelpernull_Int64{field: "`role`.`upload_quota`"},
 }
 
 // RoleRels is where relationship names are stored.
@@ -124,8 +156,8 @@ func (r *roleR) GetUserHasCourses() UserHasCourseSlice {
 type roleL struct{}
 
 var (
-	roleAllColumns            = []string{"id", "name", "display_name", "created_at", "updated_at", "deleted_at"}
-	roleColumnsWithoutDefault = []string{"name", "display_name", "updated_at", "deleted_at"}
+	roleAllColumns            = []string{"id", "name", "display_name", "created_at", "updated_at", "deleted_at", "upload_quota"}
+	roleColumnsWithoutDefault = []string{"name", "display_name", "updated_at", "deleted_at", "upload_quota"}
 	roleColumnsWithDefault    = []string{"id", "created_at"}
 	rolePrimaryKeyColumns     = []string{"id"}
 	roleGeneratedColumns      = []string{}
@@ -40,83 +40,91 @@ type Submission struct {
 	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
 	GradedAt  null.Time `boil:"graded_at" json:"graded_at,omitempty" toml:"graded_at" yaml:"graded_at,omitempty"`
 	DeletedAt null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	// Comma separated list of the file extensions allowed for solutions to this submission. If NULL, all globally allowed file types are accepted.
+	AllowedFileTypes null.String `boil:"allowed_file_types" json:"allowed_file_types,omitempty" toml:"allowed_file_types" yaml:"allowed_file_types,omitempty"`
 
 	R *submissionR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L submissionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var SubmissionColumns = struct {
-	ID          string
-	Name        string
-	Deadline    string
-	CourseID    string
-	MaxFilesize string
-	VisibleFrom string
-	CreatedAt   string
-	UpdatedAt   string
-	GradedAt    string
-	DeletedAt   string
+	ID               string
+	Name             string
+	Deadline         string
+	CourseID         string
+	MaxFilesize      string
+	VisibleFrom      string
+	CreatedAt        string
+	UpdatedAt        string
+	GradedAt         string
+	DeletedAt        string
+	AllowedFileTypes string
 }{
-	ID:          "id",
-	Name:        "name",
-	Deadline:    "deadline",
-	CourseID:    "course_id",
-	MaxFilesize: "max_filesize",
-	VisibleFrom: "visible_from",
-	CreatedAt:   "created_at",
-	UpdatedAt:   "updated_at",
-	GradedAt:    "graded_at",
-	DeletedAt:   "deleted_at",
+	ID:               "id",
+	Name:             "name",
+	Deadline:         "deadline",
+	CourseID:         "course_id",
+	MaxFilesize:      "max_filesize",
+	VisibleFrom:      "visible_from",
+	CreatedAt:        "created_at",
+	UpdatedAt:        "updated_at",
+	GradedAt:         "graded_at",
+	DeletedAt:        "deleted_at",
+	AllowedFileTypes: "allowed_file_types",
 }
 
 var SubmissionTableColumns = struct {
-	ID          string
-	Name        string
-	Deadline    string
-	CourseID    string
-	MaxFilesize string
-	VisibleFrom string
-	CreatedAt   string
-	UpdatedAt   string
-	GradedAt    string
-	DeletedAt   string
+	ID               string
+	Name             string
+	Deadline         string
+	CourseID         string
+	MaxFilesize      string
+	VisibleFrom      string
+	CreatedAt        string
+	UpdatedAt        string
+	GradedAt         string
+	DeletedAt        string
+	AllowedFileTypes string
 }{
-	ID:          "submission.id",
-	Name:        "submission.name",
-	Deadline:    "submission.deadline",
-	CourseID:    "submission.course_id",
-	MaxFilesize: "submission.max_filesize",
-	VisibleFrom: "submission.visible_from",
-	CreatedAt:   "submission.created_at",
-	UpdatedAt:   "submission.updated_at",
-	GradedAt:    "submission.graded_at",
-	DeletedAt:   "submission.deleted_at",
+	ID:               "submission.id",
+	Name:             "submission.name",
+	Deadline:         "submission.deadline",
+	CourseID:         "submission.course_id",
+	MaxFilesize:      "submission.max_filesize",
+	VisibleFrom:      "submission.visible_from",
+	CreatedAt:        "submission.created_at",
+	UpdatedAt:        "submission.updated_at",
+	GradedAt:         "submission.graded_at",
+	DeletedAt:        "submission.deleted_at",
+	AllowedFileTypes: "submission.allowed_file_types",
 }
 
 // Generated where
 
 var SubmissionWhere = struct {
-	ID          whereHelperint
-	Name        whereHelperstring
-	Deadline    whereHelpernull_Time
-	CourseID    whereHelperint
-	MaxFilesize whereHelperint
-	VisibleFrom whereHelpertime_Time
-	CreatedAt   whereHelpertime_Time
-	UpdatedAt   whereHelpernull_Time
-	GradedAt    whereHelpernull_Time
-	DeletedAt   whereHelpernull_Time
+	ID               whereHelperint
+	Name             whereHelperstring
+	Deadline         whereHelpernull_Time
+	CourseID         whereHelperint
+	MaxFilesize      whereHelperint
+	VisibleFrom      whereHelpertime_Time
+	CreatedAt        whereHelpertime_Time
+	UpdatedAt        whereHelpernull_Time
+	GradedAt         whereHelpernull_Time
+	DeletedAt        whereHelpernull_Time
+	AllowedFileTypes whereHelpernull_String
 }{
-	ID:          whereHelperint{field: "`submission`.`id`"},
-	Name:        whereHelperstring{field: "`submission`.`name`"},
-	Deadline:    whereHelpernull_Time{field: "`submission`.`deadline`"},
-	CourseID:    whereHelperint{field: "`submission`.`course_id`"},
-	MaxFilesize: whereHelperint{field: "`submission`.`max_filesize`"},
-	VisibleFrom: whereHelpertime_Time{field: "`submission`.`visible_from`"},
-	CreatedAt:   whereHelpertime_Time{field: "`submission`.`created_at`"},
-	UpdatedAt:   whereHelpernull_Time{field: "`submission`.`updated_at`"},
-	GradedAt:    whereHelpernull_Time{field: "`submission`.`graded_at`"},
-	DeletedAt:   whereHelpernull_Time{field: "`submission`.`deleted_at`"},
+	ID:               whereHelperint{field: "`submission`.`id`"},
+	Name:             whereHelperstring{field: "`submission`.`name`"},
+	Deadline:         whereHelpernull_Time{field: "`submission`.`deadline`"},
+	CourseID:         whereHelperint{field: "`submission`.`course_id`"},
+	MaxFilesize:      whereHelperint{field: "`submission`.`max_filesize`"},
+	VisibleFrom:      whereHelpertime_Time{field: "`submission`.`visible_from`"},
+	CreatedAt:        whereHelpertime_Time{field: "`submission`.`created_at`"},
+	UpdatedAt:        whereHelpernull_Time{field: "`submission`.`updated_at`"},
+	GradedAt:         whereHelpernull_Time{field: "`submission`.`graded_at`"},
+	DeletedAt:        whereHelpernull_Time{field: "`submission`.`deleted_at`"},
+	AllowedFileTypes: whereHelpernull_String{field: "`submission`.`allowed_file_types`"},
 }
 
 // SubmissionRels is where relationship names are stored.
@@ -167,8 +175,8 @@ func (r *submissionR) GetUserSubmissions() UserSubmissionSlice {
 type submissionL struct{}
 
 var (
-	submissionAllColumns            = []string{"id", "name", "deadline", "course_id", "max_filesize", "visible_from", "created_at", "updated_at", "graded_at", "deleted_at"}
-	submissionColumnsWithoutDefault = []string{"name", "deadline", "course_id", "updated_at", "graded_at", "deleted_at"}
+	submissionAllColumns            = []string{"id", "name", "deadline", "course_id", "max_filesize", "visible_from", "created_at", "updated_at", "graded_at", "deleted_at", "allowed_file_types"}
+	submissionColumnsWithoutDefault = []string{"name", "deadline", "course_id", "updated_at", "graded_at", "deleted_at", "allowed_file_types"}
 	submissionColumnsWithDefault    = []string{"id", "max_filesize", "visible_from", "created_at"}
 	submissionPrimaryKeyColumns     = []string{"id"}
 	submissionGeneratedColumns      = []string{}
@@ -637,7 +645,7 @@ func (submissionL) LoadFiles(ctx context.Context, e boil.ContextExecutor, singul
 	}
 
 	query := NewQuery(
-		qm.Select("`file`.`id`, `file`.`name`, `file`.`uri`, `file`.`local`, `file`.`uploader_id`, `file`.`created_at`, `file`.`deleted_at`, `a`.`submission_id`"),
+		qm.Select("`file`.`id`, `file`.`name`, `file`.`uri`, `file`.`local`, `file`.`uploader_id`, `file`.`created_at`, `file`.`deleted_at`, `file`.`hash`, `file`.`size`, `file`.`mime_type`, `a`.`submission_id`"),
 		qm.From("`file`"),
 		qm.InnerJoin("`submission_has_files` as `a` on `file`.`id` = `a`.`file_id`"),
 		qm.WhereIn("`a`.`submission_id` in ?", args...),
@@ -659,7 +667,7 @@ func (submissionL) LoadFiles(ctx context.Context, e boil.ContextExecutor, singul
 		one := new(File)
 		var localJoinCol int
 
-		err = results.Scan(&one.ID, &one.Name, &one.URI, &one.Local, &one.UploaderID, &one.CreatedAt, &one.DeletedAt, &localJoinCol)
+		err = results.Scan(&one.ID, &one.Name, &one.URI, &one.Local, &one.UploaderID, &one.CreatedAt, &one.DeletedAt, &one.Hash, &one.Size, &one.MimeType, &localJoinCol)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan eager loaded results for file")
 		}
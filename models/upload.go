@@ -0,0 +1,1355 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Upload is an object representing the database table.
+type Upload struct {
+	ID string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	// User uploading the file.
+	UserID int `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	// What the file is attached to once it is complete: material, exam, answer or submission.
+	Target string `boil:"target" json:"target" toml:"target" yaml:"target"`
+	// ID of the course, exam or user submission the file is attached to.
+	TargetID int    `boil:"target_id" json:"target_id" toml:"target_id" yaml:"target_id"`
+	FileName string `boil:"file_name" json:"file_name" toml:"file_name" yaml:"file_name"`
+	// Size of the complete file in bytes.
+	Length int64 `boil:"length" json:"length" toml:"length" yaml:"length"`
+	// Number of bytes received so far.
+	UploadOffset int64     `boil:"upload_offset" json:"upload_offset" toml:"upload_offset" yaml:"upload_offset"`
+	CreatedAt    time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	// When the complete file was attached to its target.
+	FinishedAt null.Time `boil:"finished_at" json:"finished_at,omitempty" toml:"finished_at" yaml:"finished_at,omitempty"`
+	// When a request started appending to the upload, NULL if none is.
+	LockedAt null.Time `boil:"locked_at" json:"locked_at,omitempty" toml:"locked_at" yaml:"locked_at,omitempty"`
+
+	R *uploadR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L uploadL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var UploadColumns = struct {
+	ID           string
+	UserID       string
+	Target       string
+	TargetID     string
+	FileName     string
+	Length       string
+	UploadOffset string
+	CreatedAt    string
+	FinishedAt   string
+	LockedAt     string
+}{
+	ID:           "id",
+	UserID:       "user_id",
+	Target:       "target",
+	TargetID:     "target_id",
+	FileName:     "file_name",
+	Length:       "length",
+	UploadOffset: "upload_offset",
+	CreatedAt:    "created_at",
+	FinishedAt:   "finished_at",
+	LockedAt:     "locked_at",
+}
+
+var UploadTableColumns = struct {
+	ID           string
+	UserID       string
+	Target       string
+	TargetID     string
+	FileName     string
+	Length       string
+	UploadOffset string
+	CreatedAt    string
+	FinishedAt   string
+	LockedAt     string
+}{
+	ID:           "upload.id",
+	UserID:       "upload.user_id",
+	Target:       "upload.target",
+	TargetID:     "upload.target_id",
+	FileName:     "upload.file_name",
+	Length:       "upload.length",
+	UploadOffset: "upload.upload_offset",
+	CreatedAt:    "upload.created_at",
+	FinishedAt:   "upload.finished_at",
+	LockedAt:     "upload.locked_at",
+}
+
+// Generated where
+
+var UploadWhere = struct {
+	ID           whereHelperstring
+	UserID       whereHelperint
+	Target       whereHelperstring
+	TargetID     whereHelperint
+	FileName     whereHelperstring
+	Length       whereHelperint64
+	UploadOffset whereHelperint64
+	CreatedAt    whereHelpertime_Time
+	FinishedAt   whereHelpernull_Time
+	LockedAt     whereHelpernull_Time
+}{
+	ID:           whereHelperstring{field: "`upload`.`id`"},
+	UserID:       whereHelperint{field: "`upload`.`user_id`"},
+	Target:       whereHelperstring{field: "`upload`.`target`"},
+	TargetID:     whereHelperint{field: "`upload`.`target_id`"},
+	FileName:     whereHelperstring{field: "`upload`.`file_name`"},
+	Length:       whereHelperint64{field: "`upload`.`length`"},
+	UploadOffset: whereHelperint64{field: "`upload`.`upload_offset`"},
+	CreatedAt:    whereHelpertime_Time{field: "`upload`.`created_at`"},
+	FinishedAt:   whereHelpernull_Time{field: "`upload`.`finished_at`"},
+	LockedAt:     whereHelpernull_Time{field: "`upload`.`locked_at`"},
+}
+
+// UploadRels is where relationship names are stored.
+var UploadRels = struct {
+	User        string
+	UploadParts string
+}{
+	User:        "User",
+	UploadParts: "UploadParts",
+}
+
+// uploadR is where relationships are stored.
+type uploadR struct {
+	User        *User           `boil:"User" json:"User" toml:"User" yaml:"User"`
+	UploadParts UploadPartSlice `boil:"UploadParts" json:"UploadParts" toml:"UploadParts" yaml:"UploadParts"`
+}
+
+// NewStruct creates a new relationship struct
+func (*uploadR) NewStruct() *uploadR {
+	return &uploadR{}
+}
+
+func (r *uploadR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+func (r *uploadR) GetUploadParts() UploadPartSlice {
+	if r == nil {
+		return nil
+	}
+	return r.UploadParts
+}
+
+// uploadL is where Load methods for each relationship are stored.
+type uploadL struct{}
+
+var (
+	uploadAllColumns            = []string{"id", "user_id", "target", "target_id", "file_name", "length", "upload_offset", "created_at", "finished_at", "locked_at"}
+	uploadColumnsWithoutDefault = []string{"id", "user_id", "target", "target_id", "file_name", "length", "finished_at", "locked_at"}
+	uploadColumnsWithDefault    = []string{"upload_offset", "created_at"}
+	uploadPrimaryKeyColumns     = []string{"id"}
+	uploadGeneratedColumns      = []string{}
+)
+
+type (
+	// UploadSlice is an alias for a slice of pointers to Upload.
+	// This should almost always be used instead of []Upload.
+	UploadSlice []*Upload
+	// UploadHook is the signature for custom Upload hook methods
+	UploadHook func(context.Context, boil.ContextExecutor, *Upload) error
+
+	uploadQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	uploadType                 = reflect.TypeOf(&Upload{})
+	uploadMapping              = queries.MakeStructMapping(uploadType)
+	uploadPrimaryKeyMapping, _ = queries.BindMapping(uploadType, uploadMapping, uploadPrimaryKeyColumns)
+	uploadInsertCacheMut       sync.RWMutex
+	uploadInsertCache          = make(map[string]insertCache)
+	uploadUpdateCacheMut       sync.RWMutex
+	uploadUpdateCache          = make(map[string]updateCache)
+	uploadUpsertCacheMut       sync.RWMutex
+	uploadUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var uploadAfterSelectHooks []UploadHook
+
+var uploadBeforeInsertHooks []UploadHook
+var uploadAfterInsertHooks []UploadHook
+
+var uploadBeforeUpdateHooks []UploadHook
+var uploadAfterUpdateHooks []UploadHook
+
+var uploadBeforeDeleteHooks []UploadHook
+var uploadAfterDeleteHooks []UploadHook
+
+var uploadBeforeUpsertHooks []UploadHook
+var uploadAfterUpsertHooks []UploadHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Upload) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range uploadAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Upload) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range uploadBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Upload) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range uploadAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Upload) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range uploadBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Upload) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range uploadAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Upload) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range uploadBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Upload) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range uploadAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Upload) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range uploadBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Upload) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range uploadAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddUploadHook registers your hook function for all future operations.
+func AddUploadHook(hookPoint boil.HookPoint, uploadHook UploadHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		uploadAfterSelectHooks = append(uploadAfterSelectHooks, uploadHook)
+	case boil.BeforeInsertHook:
+		uploadBeforeInsertHooks = append(uploadBeforeInsertHooks, uploadHook)
+	case boil.AfterInsertHook:
+		uploadAfterInsertHooks = append(uploadAfterInsertHooks, uploadHook)
+	case boil.BeforeUpdateHook:
+		uploadBeforeUpdateHooks = append(uploadBeforeUpdateHooks, uploadHook)
+	case boil.AfterUpdateHook:
+		uploadAfterUpdateHooks = append(uploadAfterUpdateHooks, uploadHook)
+	case boil.BeforeDeleteHook:
+		uploadBeforeDeleteHooks = append(uploadBeforeDeleteHooks, uploadHook)
+	case boil.AfterDeleteHook:
+		uploadAfterDeleteHooks = append(uploadAfterDeleteHooks, uploadHook)
+	case boil.BeforeUpsertHook:
+		uploadBeforeUpsertHooks = append(uploadBeforeUpsertHooks, uploadHook)
+	case boil.AfterUpsertHook:
+		uploadAfterUpsertHooks = append(uploadAfterUpsertHooks, uploadHook)
+	}
+}
+
+// One returns a single upload record from the query.
+func (q uploadQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Upload, error) {
+	o := &Upload{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for upload")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Upload records from the query.
+func (q uploadQuery) All(ctx context.Context, exec boil.ContextExecutor) (UploadSlice, error) {
+	var o []*Upload
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Upload slice")
+	}
+
+	if len(uploadAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Upload records in the query.
+func (q uploadQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count upload rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q uploadQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if upload exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *Upload) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// UploadParts retrieves all the upload_part's UploadParts with an executor.
+func (o *Upload) UploadParts(mods ...qm.QueryMod) uploadPartQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`upload_part`.`upload_id`=?", o.ID),
+	)
+
+	return UploadParts(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (uploadL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUpload interface{}, mods queries.Applicator) error {
+	var slice []*Upload
+	var object *Upload
+
+	if singular {
+		object = maybeUpload.(*Upload)
+	} else {
+		slice = *maybeUpload.(*[]*Upload)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &uploadR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &uploadR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user`),
+		qm.WhereIn(`user.id in ?`, args...),
+		qmhelper.WhereIsNull(`user.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user")
+	}
+
+	if len(uploadAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.Uploads = append(foreign.R.Uploads, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.Uploads = append(foreign.R.Uploads, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUploadParts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (uploadL) LoadUploadParts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUpload interface{}, mods queries.Applicator) error {
+	var slice []*Upload
+	var object *Upload
+
+	if singular {
+		object = maybeUpload.(*Upload)
+	} else {
+		slice = *maybeUpload.(*[]*Upload)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &uploadR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &uploadR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`upload_part`),
+		qm.WhereIn(`upload_part.upload_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load upload_part")
+	}
+
+	var resultSlice []*UploadPart
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice upload_part")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on upload_part")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for upload_part")
+	}
+
+	if len(uploadPartAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UploadParts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &uploadPartR{}
+			}
+			foreign.R.Upload = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UploadID {
+				local.R.UploadParts = append(local.R.UploadParts, foreign)
+				if foreign.R == nil {
+					foreign.R = &uploadPartR{}
+				}
+				foreign.R.Upload = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the upload to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.Uploads.
+func (o *Upload) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `upload` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, uploadPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &uploadR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			Uploads: UploadSlice{o},
+		}
+	} else {
+		related.R.Uploads = append(related.R.Uploads, o)
+	}
+
+	return nil
+}
+
+// AddUploadParts adds the given related objects to the existing relationships
+// of the upload, optionally inserting them as new records.
+// Appends related to o.R.UploadParts.
+// Sets related.R.Upload appropriately.
+func (o *Upload) AddUploadParts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UploadPart) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UploadID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `upload_part` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"upload_id"}),
+				strmangle.WhereClause("`", "`", 0, uploadPartPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UploadID, rel.Offset}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UploadID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &uploadR{
+			UploadParts: related,
+		}
+	} else {
+		o.R.UploadParts = append(o.R.UploadParts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &uploadPartR{
+				Upload: o,
+			}
+		} else {
+			rel.R.Upload = o
+		}
+	}
+	return nil
+}
+
+// Uploads retrieves all the records using an executor.
+func Uploads(mods ...qm.QueryMod) uploadQuery {
+	mods = append(mods, qm.From("`upload`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`upload`.*"})
+	}
+
+	return uploadQuery{q}
+}
+
+// FindUpload retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindUpload(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Upload, error) {
+	uploadObj := &Upload{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `upload` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, uploadObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from upload")
+	}
+
+	if err = uploadObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return uploadObj, err
+	}
+
+	return uploadObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Upload) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no upload provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(uploadColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	uploadInsertCacheMut.RLock()
+	cache, cached := uploadInsertCache[key]
+	uploadInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			uploadAllColumns,
+			uploadColumnsWithDefault,
+			uploadColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(uploadType, uploadMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(uploadType, uploadMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `upload` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `upload` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `upload` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, uploadPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into upload")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for upload")
+	}
+
+CacheNoHooks:
+	if !cached {
+		uploadInsertCacheMut.Lock()
+		uploadInsertCache[key] = cache
+		uploadInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Upload.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Upload) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	uploadUpdateCacheMut.RLock()
+	cache, cached := uploadUpdateCache[key]
+	uploadUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			uploadAllColumns,
+			uploadPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update upload, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `upload` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, uploadPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(uploadType, uploadMapping, append(wl, uploadPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update upload row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for upload")
+	}
+
+	if !cached {
+		uploadUpdateCacheMut.Lock()
+		uploadUpdateCache[key] = cache
+		uploadUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q uploadQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for upload")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for upload")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o UploadSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), uploadPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `upload` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, uploadPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in upload slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all upload")
+	}
+	return rowsAff, nil
+}
+
+var mySQLUploadUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Upload) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no upload provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(uploadColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLUploadUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	uploadUpsertCacheMut.RLock()
+	cache, cached := uploadUpsertCache[key]
+	uploadUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			uploadAllColumns,
+			uploadColumnsWithDefault,
+			uploadColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			uploadAllColumns,
+			uploadPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert upload, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`upload`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `upload` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(uploadType, uploadMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(uploadType, uploadMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for upload")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(uploadType, uploadMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for upload")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for upload")
+	}
+
+CacheNoHooks:
+	if !cached {
+		uploadUpsertCacheMut.Lock()
+		uploadUpsertCache[key] = cache
+		uploadUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Upload record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Upload) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Upload provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uploadPrimaryKeyMapping)
+	sql := "DELETE FROM `upload` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from upload")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for upload")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q uploadQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no uploadQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from upload")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for upload")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o UploadSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(uploadBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), uploadPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `upload` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, uploadPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from upload slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for upload")
+	}
+
+	if len(uploadAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Upload) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindUpload(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *UploadSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := UploadSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), uploadPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `upload`.* FROM `upload` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, uploadPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in UploadSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// UploadExists checks if the Upload row exists.
+func UploadExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `upload` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if upload exists")
+	}
+
+	return exists, nil
+}
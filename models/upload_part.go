@@ -0,0 +1,1117 @@
+// Code generated by SQLBoiler 4.11.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// UploadPart is an object representing the database table.
+type UploadPart struct {
+	UploadID string `boil:"upload_id" json:"upload_id" toml:"upload_id" yaml:"upload_id"`
+	// Position of the first byte of the part within the upload.
+	Offset int64 `boil:"offset" json:"offset" toml:"offset" yaml:"offset"`
+	// Where the bytes of the part are stored.
+	URI string `boil:"uri" json:"uri" toml:"uri" yaml:"uri"`
+	// Number of bytes in the part.
+	Size int64 `boil:"size" json:"size" toml:"size" yaml:"size"`
+
+	R *uploadPartR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L uploadPartL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var UploadPartColumns = struct {
+	UploadID string
+	Offset   string
+	URI      string
+	Size     string
+}{
+	UploadID: "upload_id",
+	Offset:   "offset",
+	URI:      "uri",
+	Size:     "size",
+}
+
+var UploadPartTableColumns = struct {
+	UploadID string
+	Offset   string
+	URI      string
+	Size     string
+}{
+	UploadID: "upload_part.upload_id",
+	Offset:   "upload_part.offset",
+	URI:      "upload_part.uri",
+	Size:     "upload_part.size",
+}
+
+// Generated where
+
+var UploadPartWhere = struct {
+	UploadID whereHelperstring
+	Offset   whereHelperint64
+	URI      whereHelperstring
+	Size     whereHelperint64
+}{
+	UploadID: whereHelperstring{field: "`upload_part`.`upload_id`"},
+	Offset:   whereHelperint64{field: "`upload_part`.`offset`"},
+	URI:      whereHelperstring{field: "`upload_part`.`uri`"},
+	Size:     whereHelperint64{field: "`upload_part`.`size`"},
+}
+
+// UploadPartRels is where relationship names are stored.
+var UploadPartRels = struct {
+	Upload string
+}{
+	Upload: "Upload",
+}
+
+// uploadPartR is where relationships are stored.
+type uploadPartR struct {
+	Upload *Upload `boil:"Upload" json:"Upload" toml:"Upload" yaml:"Upload"`
+}
+
+// NewStruct creates a new relationship struct
+func (*uploadPartR) NewStruct() *uploadPartR {
+	return &uploadPartR{}
+}
+
+func (r *uploadPartR) GetUpload() *Upload {
+	if r == nil {
+		return nil
+	}
+	return r.Upload
+}
+
+// uploadPartL is where Load methods for each relationship are stored.
+type uploadPartL struct{}
+
+var (
+	uploadPartAllColumns            = []string{"upload_id", "offset", "uri", "size"}
+	uploadPartColumnsWithoutDefault = []string{"upload_id", "offset", "uri", "size"}
+	uploadPartColumnsWithDefault    = []string{}
+	uploadPartPrimaryKeyColumns     = []string{"upload_id", "offset"}
+	uploadPartGeneratedColumns      = []string{}
+)
+
+type (
+	// UploadPartSlice is an alias for a slice of pointers to UploadPart.
+	// This should almost always be used instead of []UploadPart.
+	UploadPartSlice []*UploadPart
+	// UploadPartHook is the signature for custom UploadPart hook methods
+	UploadPartHook func(context.Context, boil.ContextExecutor, *UploadPart) error
+
+	uploadPartQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	uploadPartType                 = reflect.TypeOf(&UploadPart{})
+	uploadPartMapping              = queries.MakeStructMapping(uploadPartType)
+	uploadPartPrimaryKeyMapping, _ = queries.BindMapping(uploadPartType, uploadPartMapping, uploadPartPrimaryKeyColumns)
+	uploadPartInsertCacheMut       sync.RWMutex
+	uploadPartInsertCache          = make(map[string]insertCache)
+	uploadPartUpdateCacheMut       sync.RWMutex
+	uploadPartUpdateCache          = make(map[string]updateCache)
+	uploadPartUpsertCacheMut       sync.RWMutex
+	uploadPartUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var uploadPartAfterSelectHooks []UploadPartHook
+
+var uploadPartBeforeInsertHooks []UploadPartHook
+var uploadPartAfterInsertHooks []UploadPartHook
+
+var uploadPartBeforeUpdateHooks []UploadPartHook
+var uploadPartAfterUpdateHooks []UploadPartHook
+
+var uploadPartBeforeDeleteHooks []UploadPartHook
+var uploadPartAfterDeleteHooks []UploadPartHook
+
+var uploadPartBeforeUpsertHooks []UploadPartHook
+var uploadPartAfterUpsertHooks []UploadPartHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *UploadPart) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range uploadPartAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *UploadPart) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range uploadPartBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *UploadPart) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range uploadPartAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *UploadPart) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range uploadPartBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *UploadPart) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range uploadPartAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *UploadPart) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range uploadPartBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *UploadPart) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range uploadPartAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *UploadPart) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range uploadPartBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *UploadPart) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range uploadPartAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddUploadPartHook registers your hook function for all future operations.
+func AddUploadPartHook(hookPoint boil.HookPoint, uploadPartHook UploadPartHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		uploadPartAfterSelectHooks = append(uploadPartAfterSelectHooks, uploadPartHook)
+	case boil.BeforeInsertHook:
+		uploadPartBeforeInsertHooks = append(uploadPartBeforeInsertHooks, uploadPartHook)
+	case boil.AfterInsertHook:
+		uploadPartAfterInsertHooks = append(uploadPartAfterInsertHooks, uploadPartHook)
+	case boil.BeforeUpdateHook:
+		uploadPartBeforeUpdateHooks = append(uploadPartBeforeUpdateHooks, uploadPartHook)
+	case boil.AfterUpdateHook:
+		uploadPartAfterUpdateHooks = append(uploadPartAfterUpdateHooks, uploadPartHook)
+	case boil.BeforeDeleteHook:
+		uploadPartBeforeDeleteHooks = append(uploadPartBeforeDeleteHooks, uploadPartHook)
+	case boil.AfterDeleteHook:
+		uploadPartAfterDeleteHooks = append(uploadPartAfterDeleteHooks, uploadPartHook)
+	case boil.BeforeUpsertHook:
+		uploadPartBeforeUpsertHooks = append(uploadPartBeforeUpsertHooks, uploadPartHook)
+	case boil.AfterUpsertHook:
+		uploadPartAfterUpsertHooks = append(uploadPartAfterUpsertHooks, uploadPartHook)
+	}
+}
+
+// One returns a single uploadPart record from the query.
+func (q uploadPartQuery) One(ctx context.Context, exec boil.ContextExecutor) (*UploadPart, error) {
+	o := &UploadPart{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for upload_part")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all UploadPart records from the query.
+func (q uploadPartQuery) All(ctx context.Context, exec boil.ContextExecutor) (UploadPartSlice, error) {
+	var o []*UploadPart
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to UploadPart slice")
+	}
+
+	if len(uploadPartAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all UploadPart records in the query.
+func (q uploadPartQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count upload_part rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q uploadPartQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if upload_part exists")
+	}
+
+	return count > 0, nil
+}
+
+// Upload pointed to by the foreign key.
+func (o *UploadPart) Upload(mods ...qm.QueryMod) uploadQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.UploadID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Uploads(queryMods...)
+}
+
+// LoadUpload allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (uploadPartL) LoadUpload(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUploadPart interface{}, mods queries.Applicator) error {
+	var slice []*UploadPart
+	var object *UploadPart
+
+	if singular {
+		object = maybeUploadPart.(*UploadPart)
+	} else {
+		slice = *maybeUploadPart.(*[]*UploadPart)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &uploadPartR{}
+		}
+		args = append(args, object.UploadID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &uploadPartR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UploadID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UploadID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`upload`),
+		qm.WhereIn(`upload.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Upload")
+	}
+
+	var resultSlice []*Upload
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Upload")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for upload")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for upload")
+	}
+
+	if len(uploadPartAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Upload = foreign
+		if foreign.R == nil {
+			foreign.R = &uploadR{}
+		}
+		foreign.R.UploadParts = append(foreign.R.UploadParts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UploadID == foreign.ID {
+				local.R.Upload = foreign
+				if foreign.R == nil {
+					foreign.R = &uploadR{}
+				}
+				foreign.R.UploadParts = append(foreign.R.UploadParts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUpload of the uploadPart to the related item.
+// Sets o.R.Upload to related.
+// Adds o to related.R.UploadParts.
+func (o *UploadPart) SetUpload(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Upload) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `upload_part` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"upload_id"}),
+		strmangle.WhereClause("`", "`", 0, uploadPartPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UploadID, o.Offset}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UploadID = related.ID
+	if o.R == nil {
+		o.R = &uploadPartR{
+			Upload: related,
+		}
+	} else {
+		o.R.Upload = related
+	}
+
+	if related.R == nil {
+		related.R = &uploadR{
+			UploadParts: UploadPartSlice{o},
+		}
+	} else {
+		related.R.UploadParts = append(related.R.UploadParts, o)
+	}
+
+	return nil
+}
+
+// UploadParts retrieves all the records using an executor.
+func UploadParts(mods ...qm.QueryMod) uploadPartQuery {
+	mods = append(mods, qm.From("`upload_part`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`upload_part`.*"})
+	}
+
+	return uploadPartQuery{q}
+}
+
+// FindUploadPart retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindUploadPart(ctx context.Context, exec boil.ContextExecutor, uploadID string, offset int64, selectCols ...string) (*UploadPart, error) {
+	uploadPartObj := &UploadPart{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `upload_part` where `upload_id`=? AND `offset`=?", sel,
+	)
+
+	q := queries.Raw(query, uploadID, offset)
+
+	err := q.Bind(ctx, exec, uploadPartObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from upload_part")
+	}
+
+	if err = uploadPartObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return uploadPartObj, err
+	}
+
+	return uploadPartObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *UploadPart) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no upload_part provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(uploadPartColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	uploadPartInsertCacheMut.RLock()
+	cache, cached := uploadPartInsertCache[key]
+	uploadPartInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			uploadPartAllColumns,
+			uploadPartColumnsWithDefault,
+			uploadPartColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(uploadPartType, uploadPartMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(uploadPartType, uploadPartMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `upload_part` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `upload_part` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `upload_part` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, uploadPartPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into upload_part")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.UploadID,
+		o.Offset,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for upload_part")
+	}
+
+CacheNoHooks:
+	if !cached {
+		uploadPartInsertCacheMut.Lock()
+		uploadPartInsertCache[key] = cache
+		uploadPartInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the UploadPart.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *UploadPart) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	uploadPartUpdateCacheMut.RLock()
+	cache, cached := uploadPartUpdateCache[key]
+	uploadPartUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			uploadPartAllColumns,
+			uploadPartPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update upload_part, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `upload_part` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, uploadPartPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(uploadPartType, uploadPartMapping, append(wl, uploadPartPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update upload_part row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for upload_part")
+	}
+
+	if !cached {
+		uploadPartUpdateCacheMut.Lock()
+		uploadPartUpdateCache[key] = cache
+		uploadPartUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q uploadPartQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for upload_part")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for upload_part")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o UploadPartSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), uploadPartPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `upload_part` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, uploadPartPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in uploadPart slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all uploadPart")
+	}
+	return rowsAff, nil
+}
+
+var mySQLUploadPartUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *UploadPart) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no upload_part provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(uploadPartColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLUploadPartUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	uploadPartUpsertCacheMut.RLock()
+	cache, cached := uploadPartUpsertCache[key]
+	uploadPartUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			uploadPartAllColumns,
+			uploadPartColumnsWithDefault,
+			uploadPartColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			uploadPartAllColumns,
+			uploadPartPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert upload_part, could not build update column list")
+		}
+
+		ret = strmangle.SetComplement(ret, nzUniques)
+		cache.query = buildUpsertQueryMySQL(dialect, "`upload_part`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `upload_part` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(uploadPartType, uploadPartMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(uploadPartType, uploadPartMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for upload_part")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(uploadPartType, uploadPartMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for upload_part")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for upload_part")
+	}
+
+CacheNoHooks:
+	if !cached {
+		uploadPartUpsertCacheMut.Lock()
+		uploadPartUpsertCache[key] = cache
+		uploadPartUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single UploadPart record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *UploadPart) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no UploadPart provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uploadPartPrimaryKeyMapping)
+	sql := "DELETE FROM `upload_part` WHERE `upload_id`=? AND `offset`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from upload_part")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for upload_part")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q uploadPartQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no uploadPartQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from upload_part")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for upload_part")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o UploadPartSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(uploadPartBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), uploadPartPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `upload_part` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, uploadPartPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from uploadPart slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for upload_part")
+	}
+
+	if len(uploadPartAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *UploadPart) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindUploadPart(ctx, exec, o.UploadID, o.Offset)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *UploadPartSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := UploadPartSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), uploadPartPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `upload_part`.* FROM `upload_part` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, uploadPartPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in UploadPartSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// UploadPartExists checks if the UploadPart row exists.
+func UploadPartExists(ctx context.Context, exec boil.ContextExecutor, uploadID string, offset int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `upload_part` where `upload_id`=? AND `offset`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, uploadID, offset)
+	}
+	row := exec.QueryRowContext(ctx, sql, uploadID, offset)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if upload_part exists")
+	}
+
+	return exists, nil
+}
@@ -51,7 +51,12 @@ type User struct {
 	CreatedAt           time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt           null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
 	DeletedAt           null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
-	UploadedBytes       int       `boil:"uploaded_bytes" json:"uploaded_bytes" toml:"uploaded_bytes" yaml:"uploaded_bytes"`
+	// Size of all local files the user uploaded that aren't deleted.
+	UploadedBytes int64 `boil:"uploaded_bytes" json:"uploaded_bytes" toml:"uploaded_bytes" yaml:"uploaded_bytes"`
+	// Whether notifications are sent to the user by mail immediately, as a daily digest or not at all.
+	NotificationMail UserNotificationMail `boil:"notification_mail" json:"notification_mail" toml:"notification_mail" yaml:"notification_mail"`
+	// Number of bytes the user can upload in total, overriding the quota of the role. 0 = unlimited, NULL = use the quota of the role.
+	UploadQuota null.Int64 `boil:"upload_quota" json:"upload_quota,omitempty" toml:"upload_quota" yaml:"upload_quota,omitempty"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -76,6 +81,8 @@ var UserColumns = struct {
 	UpdatedAt           string
 	DeletedAt           string
 	UploadedBytes       string
+	NotificationMail    string
+	UploadQuota         string
 }{
 	ID:                  "id",
 	Title:               "title",
@@ -95,6 +102,8 @@ var UserColumns = struct {
 	UpdatedAt:           "updated_at",
 	DeletedAt:           "deleted_at",
 	UploadedBytes:       "uploaded_bytes",
+	NotificationMail:    "notification_mail",
+	UploadQuota:         "upload_quota",
 }
 
 var UserTableColumns = struct {
@@ -116,6 +125,8 @@ var UserTableColumns = struct {
 	UpdatedAt           string
 	DeletedAt           string
 	UploadedBytes       string
+	NotificationMail    string
+	UploadQuota         string
 }{
 	ID:                  "user.id",
 	Title:               "user.title",
@@ -135,6 +146,8 @@ var UserTableColumns = struct {
 	UpdatedAt:           "user.updated_at",
 	DeletedAt:           "user.deleted_at",
 	UploadedBytes:       "user.uploaded_bytes",
+	NotificationMail:    "user.notification_mail",
+	UploadQuota:         "user.upload_quota",
 }
 
 // Generated where
@@ -148,6 +161,27 @@ func (w whereHelper__byte) LTE(x []byte) qm.QueryMod { return qmhelper.Where(w.f
 func (w whereHelper__byte) GT(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
 func (w whereHelper__byte) GTE(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
 
+type whereHelperUserNotificationMail struct{ field string }
+
+func (w whereHelperUserNotificationMail) EQ(x UserNotificationMail) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperUserNotificationMail) NEQ(x UserNotificationMail) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperUserNotificationMail) LT(x UserNotificationMail) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperUserNotificationMail) LTE(x UserNotificationMail) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperUserNotificationMail) GT(x UserNotificationMail) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperUserNotificationMail) GTE(x UserNotificationMail) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
 var UserWhere = struct {
 	ID                  whereHelperint
 	Title               whereHelpernull_String
@@ -166,7 +200,9 @@ var UserWhere = struct {
 	CreatedAt           whereHelpertime_Time
 	UpdatedAt           whereHelpernull_Time
 	DeletedAt           whereHelpernull_Time
-	UploadedBytes       whereHelperint
+	UploadedBytes       whereHelperint64
+	NotificationMail    whereHelperUserNotificationMail
+	UploadQuota         whereHelpernull_Int64
 }{
 	ID:                  whereHelperint{field: "`user`.`id`"},
 	Title:               whereHelpernull_String{field: "`user`.`title`"},
@@ -185,55 +221,72 @@ var UserWhere = struct {
 	CreatedAt:           whereHelpertime_Time{field: "`user`.`created_at`"},
 	UpdatedAt:           whereHelpernull_Time{field: "`user`.`updated_at`"},
 	DeletedAt:           whereHelpernull_Time{field: "`user`.`deleted_at`"},
-	UploadedBytes:       whereHelperint{field: "`user`.`uploaded_bytes`"},
+	UploadedBytes:       whereHelperint64{field: "`user`.`uploaded_bytes`"},
+	NotificationMail:    whereHelperUserNotificationMail{field: "`user`.`notification_mail`"},
+	UploadQuota:         whereHelpernull_Int64{field: "`user`.`upload_quota`"},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	ProfilePictureFile       string
-	UserGraduationLevel      string
-	PreferredLanguage        string
-	Role                     string
-	Certificates             string
-	CreatorExams             string
-	UploaderFiles            string
-	AuthorForumEntries       string
-	UserToNotifications      string
-	UserHasCourses           string
-	UserHasExams             string
-	FieldOfStudies           string
-	SubmitterUserSubmissions string
+	ProfilePictureFile           string
+	UserGraduationLevel          string
+	PreferredLanguage            string
+	Role                         string
+	Certificates                 string
+	CreatorExams                 string
+	UploaderFiles                string
+	AuthorForumEntries           string
+	HiddenByForumEntries         string
+	ModeratorForumModerationLogs string
+	UserToNotifications          string
+	PasswordResets               string
+	Sessions                     string
+	Uploads                      string
+	UserHasCourses               string
+	UserHasExams                 string
+	FieldOfStudies               string
+	SubmitterUserSubmissions     string
 }{
-	ProfilePictureFile:       "ProfilePictureFile",
-	UserGraduationLevel:      "UserGraduationLevel",
-	PreferredLanguage:        "PreferredLanguage",
-	Role:                     "Role",
-	Certificates:             "Certificates",
-	CreatorExams:             "CreatorExams",
-	UploaderFiles:            "UploaderFiles",
-	AuthorForumEntries:       "AuthorForumEntries",
-	UserToNotifications:      "UserToNotifications",
-	UserHasCourses:           "UserHasCourses",
-	UserHasExams:             "UserHasExams",
-	FieldOfStudies:           "FieldOfStudies",
-	SubmitterUserSubmissions: "SubmitterUserSubmissions",
+	ProfilePictureFile:           "ProfilePictureFile",
+	UserGraduationLevel:          "UserGraduationLevel",
+	PreferredLanguage:            "PreferredLanguage",
+	Role:                         "Role",
+	Certificates:                 "Certificates",
+	CreatorExams:                 "CreatorExams",
+	UploaderFiles:                "UploaderFiles",
+	AuthorForumEntries:           "AuthorForumEntries",
+	HiddenByForumEntries:         "HiddenByForumEntries",
+	ModeratorForumModerationLogs: "ModeratorForumModerationLogs",
+	UserToNotifications:          "UserToNotifications",
+	PasswordResets:               "PasswordResets",
+	Sessions:                     "Sessions",
+	Uploads:                      "Uploads",
+	UserHasCourses:               "UserHasCourses",
+	UserHasExams:                 "UserHasExams",
+	FieldOfStudies:               "FieldOfStudies",
+	SubmitterUserSubmissions:     "SubmitterUserSubmissions",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	ProfilePictureFile       *File               `boil:"ProfilePictureFile" json:"ProfilePictureFile" toml:"ProfilePictureFile" yaml:"ProfilePictureFile"`
-	UserGraduationLevel      *GraduationLevel    `boil:"UserGraduationLevel" json:"UserGraduationLevel" toml:"UserGraduationLevel" yaml:"UserGraduationLevel"`
-	PreferredLanguage        *Language           `boil:"PreferredLanguage" json:"PreferredLanguage" toml:"PreferredLanguage" yaml:"PreferredLanguage"`
-	Role                     *Role               `boil:"Role" json:"Role" toml:"Role" yaml:"Role"`
-	Certificates             CertificateSlice    `boil:"Certificates" json:"Certificates" toml:"Certificates" yaml:"Certificates"`
-	CreatorExams             ExamSlice           `boil:"CreatorExams" json:"CreatorExams" toml:"CreatorExams" yaml:"CreatorExams"`
-	UploaderFiles            FileSlice           `boil:"UploaderFiles" json:"UploaderFiles" toml:"UploaderFiles" yaml:"UploaderFiles"`
-	AuthorForumEntries       ForumEntrySlice     `boil:"AuthorForumEntries" json:"AuthorForumEntries" toml:"AuthorForumEntries" yaml:"AuthorForumEntries"`
-	UserToNotifications      NotificationSlice   `boil:"UserToNotifications" json:"UserToNotifications" toml:"UserToNotifications" yaml:"UserToNotifications"`
-	UserHasCourses           UserHasCourseSlice  `boil:"UserHasCourses" json:"UserHasCourses" toml:"UserHasCourses" yaml:"UserHasCourses"`
-	UserHasExams             UserHasExamSlice    `boil:"UserHasExams" json:"UserHasExams" toml:"UserHasExams" yaml:"UserHasExams"`
-	FieldOfStudies           FieldOfStudySlice   `boil:"FieldOfStudies" json:"FieldOfStudies" toml:"FieldOfStudies" yaml:"FieldOfStudies"`
-	SubmitterUserSubmissions UserSubmissionSlice `boil:"SubmitterUserSubmissions" json:"SubmitterUserSubmissions" toml:"SubmitterUserSubmissions" yaml:"SubmitterUserSubmissions"`
+	ProfilePictureFile           *File                   `boil:"ProfilePictureFile" json:"ProfilePictureFile" toml:"ProfilePictureFile" yaml:"ProfilePictureFile"`
+	UserGraduationLevel          *GraduationLevel        `boil:"UserGraduationLevel" json:"UserGraduationLevel" toml:"UserGraduationLevel" yaml:"UserGraduationLevel"`
+	PreferredLanguage            *Language               `boil:"PreferredLanguage" json:"PreferredLanguage" toml:"PreferredLanguage" yaml:"PreferredLanguage"`
+	Role                         *Role                   `boil:"Role" json:"Role" toml:"Role" yaml:"Role"`
+	Certificates                 CertificateSlice        `boil:"Certificates" json:"Certificates" toml:"Certificates" yaml:"Certificates"`
+	CreatorExams                 ExamSlice               `boil:"CreatorExams" json:"CreatorExams" toml:"CreatorExams" yaml:"CreatorExams"`
+	UploaderFiles                FileSlice               `boil:"UploaderFiles" json:"UploaderFiles" toml:"UploaderFiles" yaml:"UploaderFiles"`
+	AuthorForumEntries           ForumEntrySlice         `boil:"AuthorForumEntries" json:"AuthorForumEntries" toml:"AuthorForumEntries" yaml:"AuthorForumEntries"`
+	HiddenByForumEntries         ForumEntrySlice         `boil:"HiddenByForumEntries" json:"HiddenByForumEntries" toml:"HiddenByForumEntries" yaml:"HiddenByForumEntries"`
+	ModeratorForumModerationLogs ForumModerationLogSlice `boil:"ModeratorForumModerationLogs" json:"ModeratorForumModerationLogs" toml:"ModeratorForumModerationLogs" yaml:"ModeratorForumModerationLogs"`
+	UserToNotifications          NotificationSlice       `boil:"UserToNotifications" json:"UserToNotifications" toml:"UserToNotifications" yaml:"UserToNotifications"`
+	PasswordResets               PasswordResetSlice      `boil:"PasswordResets" json:"PasswordResets" toml:"PasswordResets" yaml:"PasswordResets"`
+	Sessions                     SessionSlice            `boil:"Sessions" json:"Sessions" toml:"Sessions" yaml:"Sessions"`
+	Uploads                      UploadSlice             `boil:"Uploads" json:"Uploads" toml:"Uploads" yaml:"Uploads"`
+	UserHasCourses               UserHasCourseSlice      `boil:"UserHasCourses" json:"UserHasCourses" toml:"UserHasCourses" yaml:"UserHasCourses"`
+	UserHasExams                 UserHasExamSlice        `boil:"UserHasExams" json:"UserHasExams" toml:"UserHasExams" yaml:"UserHasExams"`
+	FieldOfStudies               FieldOfStudySlice       `boil:"FieldOfStudies" json:"FieldOfStudies" toml:"FieldOfStudies" yaml:"FieldOfStudies"`
+	SubmitterUserSubmissions     UserSubmissionSlice     `boil:"SubmitterUserSubmissions" json:"SubmitterUserSubmissions" toml:"SubmitterUserSubmissions" yaml:"SubmitterUserSubmissions"`
 }
 
 // NewStruct creates a new relationship struct
@@ -297,6 +350,20 @@ func (r *userR) GetAuthorForumEntries() ForumEntrySlice {
 	return r.AuthorForumEntries
 }
 
+func (r *userR) GetHiddenByForumEntries() ForumEntrySlice {
+	if r == nil {
+		return nil
+	}
+	return r.HiddenByForumEntries
+}
+
+func (r *userR) GetModeratorForumModerationLogs() ForumModerationLogSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ModeratorForumModerationLogs
+}
+
 func (r *userR) GetUserToNotifications() NotificationSlice {
 	if r == nil {
 		return nil
@@ -304,6 +371,27 @@ func (r *userR) GetUserToNotifications() NotificationSlice {
 	return r.UserToNotifications
 }
 
+func (r *userR) GetPasswordResets() PasswordResetSlice {
+	if r == nil {
+		return nil
+	}
+	return r.PasswordResets
+}
+
+func (r *userR) GetSessions() SessionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Sessions
+}
+
+func (r *userR) GetUploads() UploadSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Uploads
+}
+
 func (r *userR) GetUserHasCourses() UserHasCourseSlice {
 	if r == nil {
 		return nil
@@ -336,9 +424,9 @@ func (r *userR) GetSubmitterUserSubmissions() UserSubmissionSlice {
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "title", "firstname", "surname", "email", "password", "role_id", "graduation_level", "semester", "phone_number", "residence", "profile_picture", "biography", "preferred_language_id", "created_at", "updated_at", "deleted_at", "uploaded_bytes"}
-	userColumnsWithoutDefault = []string{"title", "firstname", "surname", "email", "password", "role_id", "graduation_level", "semester", "phone_number", "residence", "profile_picture", "biography", "preferred_language_id", "updated_at", "deleted_at"}
-	userColumnsWithDefault    = []string{"id", "created_at", "uploaded_bytes"}
+	userAllColumns            = []string{"id", "title", "firstname", "surname", "email", "password", "role_id", "graduation_level", "semester", "phone_number", "residence", "profile_picture", "biography", "preferred_language_id", "created_at", "updated_at", "deleted_at", "uploaded_bytes", "notification_mail", "upload_quota"}
+	userColumnsWithoutDefault = []string{"title", "firstname", "surname", "email", "password", "role_id", "graduation_level", "semester", "phone_number", "residence", "profile_picture", "biography", "preferred_language_id", "updated_at", "deleted_at", "upload_quota"}
+	userColumnsWithDefault    = []string{"id", "created_at", "uploaded_bytes", "notification_mail"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{}
 )
@@ -721,6 +809,34 @@ func (o *User) AuthorForumEntries(mods ...qm.QueryMod) forumEntryQuery {
 	return ForumEntries(queryMods...)
 }
 
+// HiddenByForumEntries retrieves all the forum_entry's ForumEntries with an executor via hidden_by_id column.
+func (o *User) HiddenByForumEntries(mods ...qm.QueryMod) forumEntryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`forum_entry`.`hidden_by_id`=?", o.ID),
+	)
+
+	return ForumEntries(queryMods...)
+}
+
+// ModeratorForumModerationLogs retrieves all the forum_moderation_log's ForumModerationLogs with an executor via moderator_id column.
+func (o *User) ModeratorForumModerationLogs(mods ...qm.QueryMod) forumModerationLogQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`forum_moderation_log`.`moderator_id`=?", o.ID),
+	)
+
+	return ForumModerationLogs(queryMods...)
+}
+
 // UserToNotifications retrieves all the notification's Notifications with an executor via user_to_id column.
 func (o *User) UserToNotifications(mods ...qm.QueryMod) notificationQuery {
 	var queryMods []qm.QueryMod
@@ -735,6 +851,48 @@ func (o *User) UserToNotifications(mods ...qm.QueryMod) notificationQuery {
 	return Notifications(queryMods...)
 }
 
+// PasswordResets retrieves all the password_reset's PasswordResets with an executor.
+func (o *User) PasswordResets(mods ...qm.QueryMod) passwordResetQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`password_reset`.`user_id`=?", o.ID),
+	)
+
+	return PasswordResets(queryMods...)
+}
+
+// Sessions retrieves all the session's Sessions with an executor.
+func (o *User) Sessions(mods ...qm.QueryMod) sessionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`session`.`user_id`=?", o.ID),
+	)
+
+	return Sessions(queryMods...)
+}
+
+// Uploads retrieves all the upload's Uploads with an executor.
+func (o *User) Uploads(mods ...qm.QueryMod) uploadQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`upload`.`user_id`=?", o.ID),
+	)
+
+	return Uploads(queryMods...)
+}
+
 // UserHasCourses retrieves all the user_has_course's UserHasCourses with an executor.
 func (o *User) UserHasCourses(mods ...qm.QueryMod) userHasCourseQuery {
 	var queryMods []qm.QueryMod
@@ -1614,9 +1772,9 @@ func (userL) LoadAuthorForumEntries(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// LoadUserToNotifications allows an eager lookup of values, cached into the
+// LoadHiddenByForumEntries allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUserToNotifications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadHiddenByForumEntries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1640,7 +1798,7 @@ func (userL) LoadUserToNotifications(ctx context.Context, e boil.ContextExecutor
 			}
 
 			for _, a := range args {
-				if a == obj.ID {
+				if queries.Equal(a, obj.ID) {
 					continue Outer
 				}
 			}
@@ -1654,9 +1812,9 @@ func (userL) LoadUserToNotifications(ctx context.Context, e boil.ContextExecutor
 	}
 
 	query := NewQuery(
-		qm.From(`notification`),
-		qm.WhereIn(`notification.user_to_id in ?`, args...),
-		qmhelper.WhereIsNull(`notification.deleted_at`),
+		qm.From(`forum_entry`),
+		qm.WhereIn(`forum_entry.hidden_by_id in ?`, args...),
+		qmhelper.WhereIsNull(`forum_entry.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1664,22 +1822,22 @@ func (userL) LoadUserToNotifications(ctx context.Context, e boil.ContextExecutor
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load notification")
+		return errors.Wrap(err, "failed to eager load forum_entry")
 	}
 
-	var resultSlice []*Notification
+	var resultSlice []*ForumEntry
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice notification")
+		return errors.Wrap(err, "failed to bind eager loaded slice forum_entry")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on notification")
+		return errors.Wrap(err, "failed to close results in eager load on forum_entry")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for forum_entry")
 	}
 
-	if len(notificationAfterSelectHooks) != 0 {
+	if len(forumEntryAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1687,24 +1845,24 @@ func (userL) LoadUserToNotifications(ctx context.Context, e boil.ContextExecutor
 		}
 	}
 	if singular {
-		object.R.UserToNotifications = resultSlice
+		object.R.HiddenByForumEntries = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &notificationR{}
+				foreign.R = &forumEntryR{}
 			}
-			foreign.R.UserTo = object
+			foreign.R.HiddenBy = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserToID {
-				local.R.UserToNotifications = append(local.R.UserToNotifications, foreign)
+			if queries.Equal(local.ID, foreign.HiddenByID) {
+				local.R.HiddenByForumEntries = append(local.R.HiddenByForumEntries, foreign)
 				if foreign.R == nil {
-					foreign.R = &notificationR{}
+					foreign.R = &forumEntryR{}
 				}
-				foreign.R.UserTo = local
+				foreign.R.HiddenBy = local
 				break
 			}
 		}
@@ -1713,9 +1871,9 @@ func (userL) LoadUserToNotifications(ctx context.Context, e boil.ContextExecutor
 	return nil
 }
 
-// LoadUserHasCourses allows an eager lookup of values, cached into the
+// LoadModeratorForumModerationLogs allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUserHasCourses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadModeratorForumModerationLogs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1753,9 +1911,8 @@ func (userL) LoadUserHasCourses(ctx context.Context, e boil.ContextExecutor, sin
 	}
 
 	query := NewQuery(
-		qm.From(`user_has_course`),
-		qm.WhereIn(`user_has_course.user_id in ?`, args...),
-		qmhelper.WhereIsNull(`user_has_course.deleted_at`),
+		qm.From(`forum_moderation_log`),
+		qm.WhereIn(`forum_moderation_log.moderator_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1763,22 +1920,22 @@ func (userL) LoadUserHasCourses(ctx context.Context, e boil.ContextExecutor, sin
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_has_course")
+		return errors.Wrap(err, "failed to eager load forum_moderation_log")
 	}
 
-	var resultSlice []*UserHasCourse
+	var resultSlice []*ForumModerationLog
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_has_course")
+		return errors.Wrap(err, "failed to bind eager loaded slice forum_moderation_log")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_has_course")
+		return errors.Wrap(err, "failed to close results in eager load on forum_moderation_log")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_has_course")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for forum_moderation_log")
 	}
 
-	if len(userHasCourseAfterSelectHooks) != 0 {
+	if len(forumModerationLogAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1786,24 +1943,24 @@ func (userL) LoadUserHasCourses(ctx context.Context, e boil.ContextExecutor, sin
 		}
 	}
 	if singular {
-		object.R.UserHasCourses = resultSlice
+		object.R.ModeratorForumModerationLogs = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userHasCourseR{}
+				foreign.R = &forumModerationLogR{}
 			}
-			foreign.R.User = object
+			foreign.R.Moderator = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.UserHasCourses = append(local.R.UserHasCourses, foreign)
+			if local.ID == foreign.ModeratorID {
+				local.R.ModeratorForumModerationLogs = append(local.R.ModeratorForumModerationLogs, foreign)
 				if foreign.R == nil {
-					foreign.R = &userHasCourseR{}
+					foreign.R = &forumModerationLogR{}
 				}
-				foreign.R.User = local
+				foreign.R.Moderator = local
 				break
 			}
 		}
@@ -1812,9 +1969,9 @@ func (userL) LoadUserHasCourses(ctx context.Context, e boil.ContextExecutor, sin
 	return nil
 }
 
-// LoadUserHasExams allows an eager lookup of values, cached into the
+// LoadUserToNotifications allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUserHasExams(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadUserToNotifications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1852,9 +2009,9 @@ func (userL) LoadUserHasExams(ctx context.Context, e boil.ContextExecutor, singu
 	}
 
 	query := NewQuery(
-		qm.From(`user_has_exam`),
-		qm.WhereIn(`user_has_exam.user_id in ?`, args...),
-		qmhelper.WhereIsNull(`user_has_exam.deleted_at`),
+		qm.From(`notification`),
+		qm.WhereIn(`notification.user_to_id in ?`, args...),
+		qmhelper.WhereIsNull(`notification.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1862,22 +2019,22 @@ func (userL) LoadUserHasExams(ctx context.Context, e boil.ContextExecutor, singu
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_has_exam")
+		return errors.Wrap(err, "failed to eager load notification")
 	}
 
-	var resultSlice []*UserHasExam
+	var resultSlice []*Notification
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_has_exam")
+		return errors.Wrap(err, "failed to bind eager loaded slice notification")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_has_exam")
+		return errors.Wrap(err, "failed to close results in eager load on notification")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_has_exam")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification")
 	}
 
-	if len(userHasExamAfterSelectHooks) != 0 {
+	if len(notificationAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1885,24 +2042,24 @@ func (userL) LoadUserHasExams(ctx context.Context, e boil.ContextExecutor, singu
 		}
 	}
 	if singular {
-		object.R.UserHasExams = resultSlice
+		object.R.UserToNotifications = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userHasExamR{}
+				foreign.R = &notificationR{}
 			}
-			foreign.R.User = object
+			foreign.R.UserTo = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.UserHasExams = append(local.R.UserHasExams, foreign)
+			if local.ID == foreign.UserToID {
+				local.R.UserToNotifications = append(local.R.UserToNotifications, foreign)
 				if foreign.R == nil {
-					foreign.R = &userHasExamR{}
+					foreign.R = &notificationR{}
 				}
-				foreign.R.User = local
+				foreign.R.UserTo = local
 				break
 			}
 		}
@@ -1911,9 +2068,9 @@ func (userL) LoadUserHasExams(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
-// LoadFieldOfStudies allows an eager lookup of values, cached into the
+// LoadPasswordResets allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadFieldOfStudies(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadPasswordResets(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1951,11 +2108,8 @@ func (userL) LoadFieldOfStudies(ctx context.Context, e boil.ContextExecutor, sin
 	}
 
 	query := NewQuery(
-		qm.Select("`field_of_study`.`id`, `field_of_study`.`name`, `field_of_study`.`semesters`, `field_of_study`.`created_at`, `field_of_study`.`updated_at`, `field_of_study`.`deleted_at`, `a`.`user_id`"),
-		qm.From("`field_of_study`"),
-		qm.InnerJoin("`user_has_field_of_study` as `a` on `field_of_study`.`id` = `a`.`field_of_study_id`"),
-		qm.WhereIn("`a`.`user_id` in ?", args...),
-		qmhelper.WhereIsNull("`field_of_study`.`deleted_at`"),
+		qm.From(`password_reset`),
+		qm.WhereIn(`password_reset.user_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1963,36 +2117,22 @@ func (userL) LoadFieldOfStudies(ctx context.Context, e boil.ContextExecutor, sin
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load field_of_study")
+		return errors.Wrap(err, "failed to eager load password_reset")
 	}
 
-	var resultSlice []*FieldOfStudy
-
-	var localJoinCols []int
-	for results.Next() {
-		one := new(FieldOfStudy)
-		var localJoinCol int
-
-		err = results.Scan(&one.ID, &one.Name, &one.Semesters, &one.CreatedAt, &one.UpdatedAt, &one.DeletedAt, &localJoinCol)
-		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for field_of_study")
-		}
-		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice field_of_study")
-		}
-
-		resultSlice = append(resultSlice, one)
-		localJoinCols = append(localJoinCols, localJoinCol)
+	var resultSlice []*PasswordReset
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice password_reset")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on field_of_study")
+		return errors.Wrap(err, "failed to close results in eager load on password_reset")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for field_of_study")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for password_reset")
 	}
 
-	if len(fieldOfStudyAfterSelectHooks) != 0 {
+	if len(passwordResetAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -2000,25 +2140,24 @@ func (userL) LoadFieldOfStudies(ctx context.Context, e boil.ContextExecutor, sin
 		}
 	}
 	if singular {
-		object.R.FieldOfStudies = resultSlice
+		object.R.PasswordResets = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &fieldOfStudyR{}
+				foreign.R = &passwordResetR{}
 			}
-			foreign.R.Users = append(foreign.R.Users, object)
+			foreign.R.User = object
 		}
 		return nil
 	}
 
-	for i, foreign := range resultSlice {
-		localJoinCol := localJoinCols[i]
+	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == localJoinCol {
-				local.R.FieldOfStudies = append(local.R.FieldOfStudies, foreign)
+			if local.ID == foreign.UserID {
+				local.R.PasswordResets = append(local.R.PasswordResets, foreign)
 				if foreign.R == nil {
-					foreign.R = &fieldOfStudyR{}
+					foreign.R = &passwordResetR{}
 				}
-				foreign.R.Users = append(foreign.R.Users, local)
+				foreign.R.User = local
 				break
 			}
 		}
@@ -2027,9 +2166,9 @@ func (userL) LoadFieldOfStudies(ctx context.Context, e boil.ContextExecutor, sin
 	return nil
 }
 
-// LoadSubmitterUserSubmissions allows an eager lookup of values, cached into the
+// LoadSessions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadSubmitterUserSubmissions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadSessions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2067,9 +2206,8 @@ func (userL) LoadSubmitterUserSubmissions(ctx context.Context, e boil.ContextExe
 	}
 
 	query := NewQuery(
-		qm.From(`user_submission`),
-		qm.WhereIn(`user_submission.submitter_id in ?`, args...),
-		qmhelper.WhereIsNull(`user_submission.deleted_at`),
+		qm.From(`session`),
+		qm.WhereIn(`session.user_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2077,22 +2215,22 @@ func (userL) LoadSubmitterUserSubmissions(ctx context.Context, e boil.ContextExe
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_submission")
+		return errors.Wrap(err, "failed to eager load session")
 	}
 
-	var resultSlice []*UserSubmission
+	var resultSlice []*Session
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_submission")
+		return errors.Wrap(err, "failed to bind eager loaded slice session")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_submission")
+		return errors.Wrap(err, "failed to close results in eager load on session")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_submission")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for session")
 	}
 
-	if len(userSubmissionAfterSelectHooks) != 0 {
+	if len(sessionAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -2100,24 +2238,24 @@ func (userL) LoadSubmitterUserSubmissions(ctx context.Context, e boil.ContextExe
 		}
 	}
 	if singular {
-		object.R.SubmitterUserSubmissions = resultSlice
+		object.R.Sessions = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userSubmissionR{}
+				foreign.R = &sessionR{}
 			}
-			foreign.R.Submitter = object
+			foreign.R.User = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.SubmitterID {
-				local.R.SubmitterUserSubmissions = append(local.R.SubmitterUserSubmissions, foreign)
+			if local.ID == foreign.UserID {
+				local.R.Sessions = append(local.R.Sessions, foreign)
 				if foreign.R == nil {
-					foreign.R = &userSubmissionR{}
+					foreign.R = &sessionR{}
 				}
-				foreign.R.Submitter = local
+				foreign.R.User = local
 				break
 			}
 		}
@@ -2126,26 +2264,697 @@ func (userL) LoadSubmitterUserSubmissions(ctx context.Context, e boil.ContextExe
 	return nil
 }
 
-// SetProfilePictureFile of the user to the related item.
-// Sets o.R.ProfilePictureFile to related.
-// Adds o to related.R.ProfilePictureUsers.
-func (o *User) SetProfilePictureFile(ctx context.Context, exec boil.ContextExecutor, insert bool, related *File) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
+// LoadUploads allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUploads(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE `user` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, []string{"profile_picture"}),
-		strmangle.WhereClause("`", "`", 0, userPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`upload`),
+		qm.WhereIn(`upload.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load upload")
+	}
+
+	var resultSlice []*Upload
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice upload")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on upload")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for upload")
+	}
+
+	if len(uploadAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Uploads = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &uploadR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Uploads = append(local.R.Uploads, foreign)
+				if foreign.R == nil {
+					foreign.R = &uploadR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserHasCourses allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserHasCourses(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_has_course`),
+		qm.WhereIn(`user_has_course.user_id in ?`, args...),
+		qmhelper.WhereIsNull(`user_has_course.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_has_course")
+	}
+
+	var resultSlice []*UserHasCourse
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_has_course")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_has_course")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_has_course")
+	}
+
+	if len(userHasCourseAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserHasCourses = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userHasCourseR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserHasCourses = append(local.R.UserHasCourses, foreign)
+				if foreign.R == nil {
+					foreign.R = &userHasCourseR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserHasExams allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserHasExams(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_has_exam`),
+		qm.WhereIn(`user_has_exam.user_id in ?`, args...),
+		qmhelper.WhereIsNull(`user_has_exam.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_has_exam")
+	}
+
+	var resultSlice []*UserHasExam
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_has_exam")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_has_exam")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_has_exam")
+	}
+
+	if len(userHasExamAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserHasExams = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userHasExamR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserHasExams = append(local.R.UserHasExams, foreign)
+				if foreign.R == nil {
+					foreign.R = &userHasExamR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFieldOfStudies allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadFieldOfStudies(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.Select("`field_of_study`.`id`, `field_of_study`.`name`, `field_of_study`.`semesters`, `field_of_study`.`created_at`, `field_of_study`.`updated_at`, `field_of_study`.`deleted_at`, `a`.`user_id`"),
+		qm.From("`field_of_study`"),
+		qm.InnerJoin("`user_has_field_of_study` as `a` on `field_of_study`.`id` = `a`.`field_of_study_id`"),
+		qm.WhereIn("`a`.`user_id` in ?", args...),
+		qmhelper.WhereIsNull("`field_of_study`.`deleted_at`"),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load field_of_study")
+	}
+
+	var resultSlice []*FieldOfStudy
+
+	var localJoinCols []int
+	for results.Next() {
+		one := new(FieldOfStudy)
+		var localJoinCol int
+
+		err = results.Scan(&one.ID, &one.Name, &one.Semesters, &one.CreatedAt, &one.UpdatedAt, &one.DeletedAt, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for field_of_study")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice field_of_study")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on field_of_study")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for field_of_study")
+	}
+
+	if len(fieldOfStudyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.FieldOfStudies = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &fieldOfStudyR{}
+			}
+			foreign.R.Users = append(foreign.R.Users, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.FieldOfStudies = append(local.R.FieldOfStudies, foreign)
+				if foreign.R == nil {
+					foreign.R = &fieldOfStudyR{}
+				}
+				foreign.R.Users = append(foreign.R.Users, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSubmitterUserSubmissions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSubmitterUserSubmissions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		object = maybeUser.(*User)
+	} else {
+		slice = *maybeUser.(*[]*User)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_submission`),
+		qm.WhereIn(`user_submission.submitter_id in ?`, args...),
+		qmhelper.WhereIsNull(`user_submission.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_submission")
+	}
+
+	var resultSlice []*UserSubmission
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_submission")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_submission")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_submission")
+	}
+
+	if len(userSubmissionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SubmitterUserSubmissions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userSubmissionR{}
+			}
+			foreign.R.Submitter = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.SubmitterID {
+				local.R.SubmitterUserSubmissions = append(local.R.SubmitterUserSubmissions, foreign)
+				if foreign.R == nil {
+					foreign.R = &userSubmissionR{}
+				}
+				foreign.R.Submitter = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetProfilePictureFile of the user to the related item.
+// Sets o.R.ProfilePictureFile to related.
+// Adds o to related.R.ProfilePictureUsers.
+func (o *User) SetProfilePictureFile(ctx context.Context, exec boil.ContextExecutor, insert bool, related *File) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `user` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"profile_picture"}),
+		strmangle.WhereClause("`", "`", 0, userPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ProfilePicture, related.ID)
+	if o.R == nil {
+		o.R = &userR{
+			ProfilePictureFile: related,
+		}
+	} else {
+		o.R.ProfilePictureFile = related
+	}
+
+	if related.R == nil {
+		related.R = &fileR{
+			ProfilePictureUsers: UserSlice{o},
+		}
+	} else {
+		related.R.ProfilePictureUsers = append(related.R.ProfilePictureUsers, o)
+	}
+
+	return nil
+}
+
+// RemoveProfilePictureFile relationship.
+// Sets o.R.ProfilePictureFile to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *User) RemoveProfilePictureFile(ctx context.Context, exec boil.ContextExecutor, related *File) error {
+	var err error
+
+	queries.SetScanner(&o.ProfilePicture, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("profile_picture")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ProfilePictureFile = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ProfilePictureUsers {
+		if queries.Equal(o.ProfilePicture, ri.ProfilePicture) {
+			continue
+		}
+
+		ln := len(related.R.ProfilePictureUsers)
+		if ln > 1 && i < ln-1 {
+			related.R.ProfilePictureUsers[i] = related.R.ProfilePictureUsers[ln-1]
+		}
+		related.R.ProfilePictureUsers = related.R.ProfilePictureUsers[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetUserGraduationLevel of the user to the related item.
+// Sets o.R.UserGraduationLevel to related.
+// Adds o to related.R.Users.
+func (o *User) SetUserGraduationLevel(ctx context.Context, exec boil.ContextExecutor, insert bool, related *GraduationLevel) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `user` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"graduation_level"}),
+		strmangle.WhereClause("`", "`", 0, userPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.GraduationLevel, related.ID)
+	if o.R == nil {
+		o.R = &userR{
+			UserGraduationLevel: related,
+		}
+	} else {
+		o.R.UserGraduationLevel = related
+	}
+
+	if related.R == nil {
+		related.R = &graduationLevelR{
+			Users: UserSlice{o},
+		}
+	} else {
+		related.R.Users = append(related.R.Users, o)
+	}
+
+	return nil
+}
+
+// RemoveUserGraduationLevel relationship.
+// Sets o.R.UserGraduationLevel to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *User) RemoveUserGraduationLevel(ctx context.Context, exec boil.ContextExecutor, related *GraduationLevel) error {
+	var err error
+
+	queries.SetScanner(&o.GraduationLevel, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("graduation_level")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.UserGraduationLevel = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Users {
+		if queries.Equal(o.GraduationLevel, ri.GraduationLevel) {
+			continue
+		}
+
+		ln := len(related.R.Users)
+		if ln > 1 && i < ln-1 {
+			related.R.Users[i] = related.R.Users[ln-1]
+		}
+		related.R.Users = related.R.Users[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetPreferredLanguage of the user to the related item.
+// Sets o.R.PreferredLanguage to related.
+// Adds o to related.R.PreferredLanguageUsers.
+func (o *User) SetPreferredLanguage(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Language) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `user` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"preferred_language_id"}),
+		strmangle.WhereClause("`", "`", 0, userPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, updateQuery)
 		fmt.Fprintln(writer, values)
 	}
@@ -2153,63 +2962,30 @@ func (o *User) SetProfilePictureFile(ctx context.Context, exec boil.ContextExecu
 		return errors.Wrap(err, "failed to update local table")
 	}
 
-	queries.Assign(&o.ProfilePicture, related.ID)
+	o.PreferredLanguageID = related.ID
 	if o.R == nil {
 		o.R = &userR{
-			ProfilePictureFile: related,
+			PreferredLanguage: related,
 		}
 	} else {
-		o.R.ProfilePictureFile = related
+		o.R.PreferredLanguage = related
 	}
 
 	if related.R == nil {
-		related.R = &fileR{
-			ProfilePictureUsers: UserSlice{o},
+		related.R = &languageR{
+			PreferredLanguageUsers: UserSlice{o},
 		}
 	} else {
-		related.R.ProfilePictureUsers = append(related.R.ProfilePictureUsers, o)
-	}
-
-	return nil
-}
-
-// RemoveProfilePictureFile relationship.
-// Sets o.R.ProfilePictureFile to nil.
-// Removes o from all passed in related items' relationships struct.
-func (o *User) RemoveProfilePictureFile(ctx context.Context, exec boil.ContextExecutor, related *File) error {
-	var err error
-
-	queries.SetScanner(&o.ProfilePicture, nil)
-	if _, err = o.Update(ctx, exec, boil.Whitelist("profile_picture")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
-
-	if o.R != nil {
-		o.R.ProfilePictureFile = nil
-	}
-	if related == nil || related.R == nil {
-		return nil
+		related.R.PreferredLanguageUsers = append(related.R.PreferredLanguageUsers, o)
 	}
 
-	for i, ri := range related.R.ProfilePictureUsers {
-		if queries.Equal(o.ProfilePicture, ri.ProfilePicture) {
-			continue
-		}
-
-		ln := len(related.R.ProfilePictureUsers)
-		if ln > 1 && i < ln-1 {
-			related.R.ProfilePictureUsers[i] = related.R.ProfilePictureUsers[ln-1]
-		}
-		related.R.ProfilePictureUsers = related.R.ProfilePictureUsers[:ln-1]
-		break
-	}
 	return nil
 }
 
-// SetUserGraduationLevel of the user to the related item.
-// Sets o.R.UserGraduationLevel to related.
+// SetRole of the user to the related item.
+// Sets o.R.Role to related.
 // Adds o to related.R.Users.
-func (o *User) SetUserGraduationLevel(ctx context.Context, exec boil.ContextExecutor, insert bool, related *GraduationLevel) error {
+func (o *User) SetRole(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Role) error {
 	var err error
 	if insert {
 		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
@@ -2219,7 +2995,7 @@ func (o *User) SetUserGraduationLevel(ctx context.Context, exec boil.ContextExec
 
 	updateQuery := fmt.Sprintf(
 		"UPDATE `user` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, []string{"graduation_level"}),
+		strmangle.SetParamNames("`", "`", 0, []string{"role_id"}),
 		strmangle.WhereClause("`", "`", 0, userPrimaryKeyColumns),
 	)
 	values := []interface{}{related.ID, o.ID}
@@ -2233,17 +3009,17 @@ func (o *User) SetUserGraduationLevel(ctx context.Context, exec boil.ContextExec
 		return errors.Wrap(err, "failed to update local table")
 	}
 
-	queries.Assign(&o.GraduationLevel, related.ID)
+	o.RoleID = related.ID
 	if o.R == nil {
 		o.R = &userR{
-			UserGraduationLevel: related,
+			Role: related,
 		}
 	} else {
-		o.R.UserGraduationLevel = related
+		o.R.Role = related
 	}
 
 	if related.R == nil {
-		related.R = &graduationLevelR{
+		related.R = &roleR{
 			Users: UserSlice{o},
 		}
 	} else {
@@ -2253,150 +3029,362 @@ func (o *User) SetUserGraduationLevel(ctx context.Context, exec boil.ContextExec
 	return nil
 }
 
-// RemoveUserGraduationLevel relationship.
-// Sets o.R.UserGraduationLevel to nil.
-// Removes o from all passed in related items' relationships struct.
-func (o *User) RemoveUserGraduationLevel(ctx context.Context, exec boil.ContextExecutor, related *GraduationLevel) error {
+// AddCertificates adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Certificates.
+// Sets related.R.User appropriately.
+func (o *User) AddCertificates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Certificate) error {
 	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `certificate` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, certificatePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	queries.SetScanner(&o.GraduationLevel, nil)
-	if _, err = o.Update(ctx, exec, boil.Whitelist("graduation_level")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Certificates: related,
+		}
+	} else {
+		o.R.Certificates = append(o.R.Certificates, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &certificateR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddCreatorExams adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatorExams.
+// Sets related.R.Creator appropriately.
+func (o *User) AddCreatorExams(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Exam) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CreatorID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `exam` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"creator_id"}),
+				strmangle.WhereClause("`", "`", 0, examPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CreatorID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatorExams: related,
+		}
+	} else {
+		o.R.CreatorExams = append(o.R.CreatorExams, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &examR{
+				Creator: o,
+			}
+		} else {
+			rel.R.Creator = o
+		}
+	}
+	return nil
+}
+
+// AddUploaderFiles adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UploaderFiles.
+// Sets related.R.Uploader appropriately.
+func (o *User) AddUploaderFiles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*File) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UploaderID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `file` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"uploader_id"}),
+				strmangle.WhereClause("`", "`", 0, filePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UploaderID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UploaderFiles: related,
+		}
+	} else {
+		o.R.UploaderFiles = append(o.R.UploaderFiles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &fileR{
+				Uploader: o,
+			}
+		} else {
+			rel.R.Uploader = o
+		}
 	}
+	return nil
+}
+
+// AddAuthorForumEntries adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AuthorForumEntries.
+// Sets related.R.Author appropriately.
+func (o *User) AddAuthorForumEntries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ForumEntry) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AuthorID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `forum_entry` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"author_id"}),
+				strmangle.WhereClause("`", "`", 0, forumEntryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	if o.R != nil {
-		o.R.UserGraduationLevel = nil
-	}
-	if related == nil || related.R == nil {
-		return nil
+			rel.AuthorID = o.ID
+		}
 	}
 
-	for i, ri := range related.R.Users {
-		if queries.Equal(o.GraduationLevel, ri.GraduationLevel) {
-			continue
+	if o.R == nil {
+		o.R = &userR{
+			AuthorForumEntries: related,
 		}
+	} else {
+		o.R.AuthorForumEntries = append(o.R.AuthorForumEntries, related...)
+	}
 
-		ln := len(related.R.Users)
-		if ln > 1 && i < ln-1 {
-			related.R.Users[i] = related.R.Users[ln-1]
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &forumEntryR{
+				Author: o,
+			}
+		} else {
+			rel.R.Author = o
 		}
-		related.R.Users = related.R.Users[:ln-1]
-		break
 	}
 	return nil
 }
 
-// SetPreferredLanguage of the user to the related item.
-// Sets o.R.PreferredLanguage to related.
-// Adds o to related.R.PreferredLanguageUsers.
-func (o *User) SetPreferredLanguage(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Language) error {
+// AddHiddenByForumEntries adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.HiddenByForumEntries.
+// Sets related.R.HiddenBy appropriately.
+func (o *User) AddHiddenByForumEntries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ForumEntry) error {
 	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.HiddenByID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `forum_entry` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"hidden_by_id"}),
+				strmangle.WhereClause("`", "`", 0, forumEntryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE `user` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, []string{"preferred_language_id"}),
-		strmangle.WhereClause("`", "`", 0, userPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+			queries.Assign(&rel.HiddenByID, o.ID)
+		}
 	}
 
-	o.PreferredLanguageID = related.ID
 	if o.R == nil {
 		o.R = &userR{
-			PreferredLanguage: related,
+			HiddenByForumEntries: related,
 		}
 	} else {
-		o.R.PreferredLanguage = related
+		o.R.HiddenByForumEntries = append(o.R.HiddenByForumEntries, related...)
 	}
 
-	if related.R == nil {
-		related.R = &languageR{
-			PreferredLanguageUsers: UserSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &forumEntryR{
+				HiddenBy: o,
+			}
+		} else {
+			rel.R.HiddenBy = o
 		}
-	} else {
-		related.R.PreferredLanguageUsers = append(related.R.PreferredLanguageUsers, o)
 	}
-
 	return nil
 }
 
-// SetRole of the user to the related item.
-// Sets o.R.Role to related.
-// Adds o to related.R.Users.
-func (o *User) SetRole(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Role) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE `user` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, []string{"role_id"}),
-		strmangle.WhereClause("`", "`", 0, userPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
+// SetHiddenByForumEntries removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.HiddenBy's HiddenByForumEntries accordingly.
+// Replaces o.R.HiddenByForumEntries with related.
+// Sets related.R.HiddenBy's HiddenByForumEntries accordingly.
+func (o *User) SetHiddenByForumEntries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ForumEntry) error {
+	query := "update `forum_entry` set `hidden_by_id` = null where `hidden_by_id` = ?"
+	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, query)
 		fmt.Fprintln(writer, values)
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	o.RoleID = related.ID
-	if o.R == nil {
-		o.R = &userR{
-			Role: related,
+	if o.R != nil {
+		for _, rel := range o.R.HiddenByForumEntries {
+			queries.SetScanner(&rel.HiddenByID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.HiddenBy = nil
 		}
-	} else {
-		o.R.Role = related
+		o.R.HiddenByForumEntries = nil
 	}
 
-	if related.R == nil {
-		related.R = &roleR{
-			Users: UserSlice{o},
+	return o.AddHiddenByForumEntries(ctx, exec, insert, related...)
+}
+
+// RemoveHiddenByForumEntries relationships from objects passed in.
+// Removes related items from R.HiddenByForumEntries (uses pointer comparison, removal does not keep order)
+// Sets related.R.HiddenBy.
+func (o *User) RemoveHiddenByForumEntries(ctx context.Context, exec boil.ContextExecutor, related ...*ForumEntry) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.HiddenByID, nil)
+		if rel.R != nil {
+			rel.R.HiddenBy = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("hidden_by_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.HiddenByForumEntries {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.HiddenByForumEntries)
+			if ln > 1 && i < ln-1 {
+				o.R.HiddenByForumEntries[i] = o.R.HiddenByForumEntries[ln-1]
+			}
+			o.R.HiddenByForumEntries = o.R.HiddenByForumEntries[:ln-1]
+			break
 		}
-	} else {
-		related.R.Users = append(related.R.Users, o)
 	}
 
 	return nil
 }
 
-// AddCertificates adds the given related objects to the existing relationships
+// AddModeratorForumModerationLogs adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.Certificates.
-// Sets related.R.User appropriately.
-func (o *User) AddCertificates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Certificate) error {
+// Appends related to o.R.ModeratorForumModerationLogs.
+// Sets related.R.Moderator appropriately.
+func (o *User) AddModeratorForumModerationLogs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ForumModerationLog) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.UserID = o.ID
+			rel.ModeratorID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `certificate` SET %s WHERE %s",
-				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
-				strmangle.WhereClause("`", "`", 0, certificatePrimaryKeyColumns),
+				"UPDATE `forum_moderation_log` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"moderator_id"}),
+				strmangle.WhereClause("`", "`", 0, forumModerationLogPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2409,47 +3397,47 @@ func (o *User) AddCertificates(ctx context.Context, exec boil.ContextExecutor, i
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.UserID = o.ID
+			rel.ModeratorID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			Certificates: related,
+			ModeratorForumModerationLogs: related,
 		}
 	} else {
-		o.R.Certificates = append(o.R.Certificates, related...)
+		o.R.ModeratorForumModerationLogs = append(o.R.ModeratorForumModerationLogs, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &certificateR{
-				User: o,
+			rel.R = &forumModerationLogR{
+				Moderator: o,
 			}
 		} else {
-			rel.R.User = o
+			rel.R.Moderator = o
 		}
 	}
 	return nil
 }
 
-// AddCreatorExams adds the given related objects to the existing relationships
+// AddUserToNotifications adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.CreatorExams.
-// Sets related.R.Creator appropriately.
-func (o *User) AddCreatorExams(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Exam) error {
+// Appends related to o.R.UserToNotifications.
+// Sets related.R.UserTo appropriately.
+func (o *User) AddUserToNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.CreatorID = o.ID
+			rel.UserToID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `exam` SET %s WHERE %s",
-				strmangle.SetParamNames("`", "`", 0, []string{"creator_id"}),
-				strmangle.WhereClause("`", "`", 0, examPrimaryKeyColumns),
+				"UPDATE `notification` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_to_id"}),
+				strmangle.WhereClause("`", "`", 0, notificationPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2462,47 +3450,47 @@ func (o *User) AddCreatorExams(ctx context.Context, exec boil.ContextExecutor, i
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.CreatorID = o.ID
+			rel.UserToID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			CreatorExams: related,
+			UserToNotifications: related,
 		}
 	} else {
-		o.R.CreatorExams = append(o.R.CreatorExams, related...)
+		o.R.UserToNotifications = append(o.R.UserToNotifications, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &examR{
-				Creator: o,
+			rel.R = &notificationR{
+				UserTo: o,
 			}
 		} else {
-			rel.R.Creator = o
+			rel.R.UserTo = o
 		}
 	}
 	return nil
 }
 
-// AddUploaderFiles adds the given related objects to the existing relationships
+// AddPasswordResets adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.UploaderFiles.
-// Sets related.R.Uploader appropriately.
-func (o *User) AddUploaderFiles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*File) error {
+// Appends related to o.R.PasswordResets.
+// Sets related.R.User appropriately.
+func (o *User) AddPasswordResets(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PasswordReset) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.UploaderID = o.ID
+			rel.UserID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `file` SET %s WHERE %s",
-				strmangle.SetParamNames("`", "`", 0, []string{"uploader_id"}),
-				strmangle.WhereClause("`", "`", 0, filePrimaryKeyColumns),
+				"UPDATE `password_reset` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, passwordResetPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2515,47 +3503,47 @@ func (o *User) AddUploaderFiles(ctx context.Context, exec boil.ContextExecutor,
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.UploaderID = o.ID
+			rel.UserID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			UploaderFiles: related,
+			PasswordResets: related,
 		}
 	} else {
-		o.R.UploaderFiles = append(o.R.UploaderFiles, related...)
+		o.R.PasswordResets = append(o.R.PasswordResets, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &fileR{
-				Uploader: o,
+			rel.R = &passwordResetR{
+				User: o,
 			}
 		} else {
-			rel.R.Uploader = o
+			rel.R.User = o
 		}
 	}
 	return nil
 }
 
-// AddAuthorForumEntries adds the given related objects to the existing relationships
+// AddSessions adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.AuthorForumEntries.
-// Sets related.R.Author appropriately.
-func (o *User) AddAuthorForumEntries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ForumEntry) error {
+// Appends related to o.R.Sessions.
+// Sets related.R.User appropriately.
+func (o *User) AddSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Session) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.AuthorID = o.ID
+			rel.UserID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `forum_entry` SET %s WHERE %s",
-				strmangle.SetParamNames("`", "`", 0, []string{"author_id"}),
-				strmangle.WhereClause("`", "`", 0, forumEntryPrimaryKeyColumns),
+				"UPDATE `session` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, sessionPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2568,47 +3556,47 @@ func (o *User) AddAuthorForumEntries(ctx context.Context, exec boil.ContextExecu
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.AuthorID = o.ID
+			rel.UserID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			AuthorForumEntries: related,
+			Sessions: related,
 		}
 	} else {
-		o.R.AuthorForumEntries = append(o.R.AuthorForumEntries, related...)
+		o.R.Sessions = append(o.R.Sessions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &forumEntryR{
-				Author: o,
+			rel.R = &sessionR{
+				User: o,
 			}
 		} else {
-			rel.R.Author = o
+			rel.R.User = o
 		}
 	}
 	return nil
 }
 
-// AddUserToNotifications adds the given related objects to the existing relationships
+// AddUploads adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.UserToNotifications.
-// Sets related.R.UserTo appropriately.
-func (o *User) AddUserToNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
+// Appends related to o.R.Uploads.
+// Sets related.R.User appropriately.
+func (o *User) AddUploads(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Upload) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.UserToID = o.ID
+			rel.UserID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `notification` SET %s WHERE %s",
-				strmangle.SetParamNames("`", "`", 0, []string{"user_to_id"}),
-				strmangle.WhereClause("`", "`", 0, notificationPrimaryKeyColumns),
+				"UPDATE `upload` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, uploadPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2621,25 +3609,25 @@ func (o *User) AddUserToNotifications(ctx context.Context, exec boil.ContextExec
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.UserToID = o.ID
+			rel.UserID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			UserToNotifications: related,
+			Uploads: related,
 		}
 	} else {
-		o.R.UserToNotifications = append(o.R.UserToNotifications, related...)
+		o.R.Uploads = append(o.R.Uploads, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &notificationR{
-				UserTo: o,
+			rel.R = &uploadR{
+				User: o,
 			}
 		} else {
-			rel.R.UserTo = o
+			rel.R.User = o
 		}
 	}
 	return nil
@@ -0,0 +1,88 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"learningbay24.de/backend/models"
+)
+
+// number of notifications buffered per subscriber before it is dropped
+const subscriberBufferSize = 16
+
+// Broker passes newly created notifications on to the users currently subscribed to them.
+// It only works within a single process.
+type Broker struct {
+	mu          sync.Mutex
+	subscribers map[int]map[chan *models.Notification]struct{}
+}
+
+// DefaultBroker is the broker every notification created through Notify is published to
+var DefaultBroker = NewBroker()
+
+// NewBroker returns a broker without any subscribers
+func NewBroker() *Broker {
+	return &Broker{subscribers: make(map[int]map[chan *models.Notification]struct{})}
+}
+
+// Subscribe takes the ID of a user and returns a channel receiving all notifications published for that user
+// and a function to cancel the subscription. The channel is closed once the subscription ends, which also happens
+// when the subscriber can't keep up with the published notifications.
+func (b *Broker) Subscribe(userId int) (<-chan *models.Notification, func()) {
+	ch := make(chan *models.Notification, subscriberBufferSize)
+
+	b.mu.Lock()
+	if b.subscribers[userId] == nil {
+		b.subscribers[userId] = make(map[chan *models.Notification]struct{})
+	}
+	b.subscribers[userId][ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.remove(userId, ch)
+	}
+}
+
+// Publish takes a notification and passes it on to all subscribers of its receiving user
+func (b *Broker) Publish(n *models.Notification) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subscribers[n.UserToID] {
+		select {
+		case ch <- n:
+		default:
+			// the subscriber is too slow, drop it so it reconnects and catches up using the last ID it received
+			b.remove(n.UserToID, ch)
+		}
+	}
+}
+
+// remove closes and removes a subscriber. The caller has to hold the lock.
+func (b *Broker) remove(userId int, ch chan *models.Notification) {
+	if _, ok := b.subscribers[userId][ch]; !ok {
+		return
+	}
+	delete(b.subscribers[userId], ch)
+	close(ch)
+	if len(b.subscribers[userId]) == 0 {
+		delete(b.subscribers, userId)
+	}
+}
+
+// GetNotificationsSince takes the ID of a user and a notification and returns all notifications of the user created after it, oldest first
+func GetNotificationsSince(db *sql.DB, userId, notificationId int) (models.NotificationSlice, error) {
+	notifications, err := models.Notifications(
+		models.NotificationWhere.UserToID.EQ(userId),
+		models.NotificationWhere.ID.GT(notificationId),
+		qm.OrderBy(models.NotificationColumns.ID+" ASC"),
+	).All(context.Background(), db)
+	if err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
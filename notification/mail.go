@@ -0,0 +1,142 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"learningbay24.de/backend/config"
+	"learningbay24.de/backend/mail"
+	"learningbay24.de/backend/models"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// MailSender is used to send notifications by mail. If it is nil, no mails are sent.
+var MailSender mail.Sender
+
+type notificationMail struct {
+	Name         string
+	BaseURL      string
+	Notification *models.Notification
+}
+
+type digestMail struct {
+	Name          string
+	BaseURL       string
+	Notifications models.NotificationSlice
+}
+
+// SetMailPreference takes the ID of a user and sets whether they receive notifications by mail immediately, as a daily digest or not at all
+func SetMailPreference(db *sql.DB, userId int, preference models.UserNotificationMail) error {
+	if err := preference.IsValid(); err != nil {
+		return err
+	}
+
+	u, err := models.FindUser(context.Background(), db, userId)
+	if err != nil {
+		return err
+	}
+
+	u.NotificationMail = preference
+	_, err = u.Update(context.Background(), db, boil.Whitelist(models.UserColumns.NotificationMail, models.UserColumns.UpdatedAt))
+	return err
+}
+
+// mailNotification takes a notification and sends it to its user by mail, if they want to receive notifications immediately
+func mailNotification(db *sql.DB, n *models.Notification) error {
+	if MailSender == nil {
+		return nil
+	}
+
+	u, err := models.FindUser(context.Background(), db, n.UserToID)
+	if err != nil {
+		return err
+	}
+	if u.NotificationMail != models.UserNotificationMailImmediate {
+		return nil
+	}
+
+	m, err := mail.Render(u.PreferredLanguageID, "notification", notificationMail{Name: u.Firstname, BaseURL: config.BaseURL(), Notification: n})
+	if err != nil {
+		return err
+	}
+	m.To = u.Email
+
+	if err := MailSender.Send(m); err != nil {
+		return err
+	}
+
+	n.MailedAt = null.TimeFrom(time.Now())
+	_, err = n.Update(context.Background(), db, boil.Whitelist(models.NotificationColumns.MailedAt, models.NotificationColumns.UpdatedAt))
+	return err
+}
+
+// SendDigests sends every user that wants to receive a daily digest a mail containing all of their unread notifications that haven't been mailed yet
+func SendDigests(db *sql.DB) error {
+	if MailSender == nil {
+		return nil
+	}
+
+	users, err := models.Users(models.UserWhere.NotificationMail.EQ(models.UserNotificationMailDigest)).All(context.Background(), db)
+	if err != nil {
+		return err
+	}
+
+	for _, u := range users {
+		// a single failing digest shouldn't keep the other users from receiving theirs
+		if err := sendDigest(db, u); err != nil {
+			log.Errorf("Unable to send digest to user %d: %s", u.ID, err.Error())
+		}
+	}
+	return nil
+}
+
+// sendDigest takes a user and sends them all of their unread notifications that haven't been mailed yet
+func sendDigest(db *sql.DB, u *models.User) error {
+	notifications, err := models.Notifications(
+		models.NotificationWhere.UserToID.EQ(u.ID),
+		models.NotificationWhere.TimeRead.IsNull(),
+		models.NotificationWhere.MailedAt.IsNull(),
+		qm.OrderBy(models.NotificationColumns.CreatedAt+" ASC"),
+	).All(context.Background(), db)
+	if err != nil {
+		return err
+	}
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	m, err := mail.Render(u.PreferredLanguageID, "digest", digestMail{Name: u.Firstname, BaseURL: config.BaseURL(), Notifications: notifications})
+	if err != nil {
+		return err
+	}
+	m.To = u.Email
+
+	if err := MailSender.Send(m); err != nil {
+		return err
+	}
+
+	_, err = notifications.UpdateAll(context.Background(), db, models.M{models.NotificationColumns.MailedAt: time.Now()})
+	return err
+}
+
+// ScheduleDigests sends the daily digests every day at the given hour. It never returns and is meant to be run in its own goroutine.
+func ScheduleDigests(db *sql.DB, hour int) {
+	for {
+		now := time.Now()
+		next := time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, now.Location())
+		if !next.After(now) {
+			next = next.AddDate(0, 0, 1)
+		}
+		time.Sleep(time.Until(next))
+
+		log.Info("Sending daily notification digests")
+		if err := SendDigests(db); err != nil {
+			log.Errorf("Unable to send daily notification digests: %s", err.Error())
+		}
+	}
+}
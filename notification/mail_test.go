@@ -0,0 +1,65 @@
+package notification
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"learningbay24.de/backend/dbi"
+	"learningbay24.de/backend/mail"
+	"learningbay24.de/backend/models"
+)
+
+func userRows(preference models.UserNotificationMail) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "firstname", "email", "preferred_language_id", "notification_mail"}).
+		AddRow(1, "Ada", "ada@learningbay24.de", dbi.EnglishLanguageId, preference)
+}
+
+func TestMailNotificationImmediate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+
+	sender := &mail.MemorySender{}
+	MailSender = sender
+	defer func() { MailSender = nil }()
+
+	mock.ExpectQuery(regexp.QuoteMeta("from `user`")).WithArgs(1).WillReturnRows(userRows(models.UserNotificationMailImmediate))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `notification` SET `mailed_at`=?")).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	n := &models.Notification{ID: 1, Title: "Exam graded", UserToID: 1}
+	assert.NoError(t, mailNotification(db, n))
+	assert.True(t, n.MailedAt.Valid)
+
+	sent := sender.Sent()
+	if assert.Len(t, sent, 1) {
+		assert.Equal(t, "ada@learningbay24.de", sent[0].To)
+		assert.Equal(t, "[LearningBay24] Exam graded", sent[0].Subject)
+		assert.Contains(t, sent[0].Body, "Hello Ada,")
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMailNotificationDigest(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+
+	sender := &mail.MemorySender{}
+	MailSender = sender
+	defer func() { MailSender = nil }()
+
+	// users receiving a digest don't get a mail for every notification
+	mock.ExpectQuery(regexp.QuoteMeta("from `user`")).WithArgs(1).WillReturnRows(userRows(models.UserNotificationMailDigest))
+
+	n := &models.Notification{ID: 1, Title: "Exam graded", UserToID: 1}
+	assert.NoError(t, mailNotification(db, n))
+	assert.False(t, n.MailedAt.Valid)
+	assert.Empty(t, sender.Sent())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,146 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"learningbay24.de/backend/models"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// maximum lengths of the corresponding columns in the notification table
+const (
+	maxTitleLength = 64
+	maxBodyLength  = 128
+)
+
+// Notify takes the ID of a user, a title, a body and an URL and creates a new notification for that user.
+// Body and URL are optional, title and body are cut off if they are too long.
+// The notification is published to the DefaultBroker once it is created and mailed to the user in the background, if they want to receive it immediately.
+func Notify(db *sql.DB, userId int, title, body, url string) (int, error) {
+	if title == "" {
+		return 0, errors.New("title can't be empty")
+	}
+
+	n := &models.Notification{Title: truncate(title, maxTitleLength), UserToID: userId}
+	if body != "" {
+		n.Body = null.StringFrom(truncate(body, maxBodyLength))
+	}
+	if url != "" {
+		n.URL = null.StringFrom(url)
+	}
+
+	err := n.Insert(context.Background(), db, boil.Infer())
+	if err != nil {
+		return 0, err
+	}
+
+	DefaultBroker.Publish(n)
+
+	if MailSender != nil {
+		// copy the notification, as the one published is shared with the subscribers
+		mailed := *n
+		go func() {
+			if err := mailNotification(db, &mailed); err != nil {
+				log.Errorf("Unable to send notification %d by mail: %s", mailed.ID, err.Error())
+			}
+		}()
+	}
+	return n.ID, nil
+}
+
+// NotifyCourse takes the ID of a course, a course role, the ID of a user to leave out, a title, a body and an URL
+// and notifies every member of the course that has at least the given role.
+// Pass the ID of the user causing the event as exceptUserId so they aren't notified about their own actions.
+func NotifyCourse(db *sql.DB, courseId, roleId, exceptUserId int, title, body, url string) error {
+	members, err := models.UserHasCourses(
+		models.UserHasCourseWhere.CourseID.EQ(courseId),
+		models.UserHasCourseWhere.RoleID.LTE(roleId),
+		models.UserHasCourseWhere.UserID.NEQ(exceptUserId),
+	).All(context.Background(), db)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range members {
+		_, err := Notify(db, m.UserID, title, body, url)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetNotifications takes the ID of a user and returns all of their notifications, newest first
+func GetNotifications(db *sql.DB, userId int) (models.NotificationSlice, error) {
+	notifications, err := models.Notifications(
+		models.NotificationWhere.UserToID.EQ(userId),
+		qm.OrderBy(models.NotificationColumns.CreatedAt+" DESC"),
+	).All(context.Background(), db)
+	if err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+// MarkRead takes the ID of a user and a notification and marks the notification as read, if it belongs to the user
+func MarkRead(db *sql.DB, userId, notificationId int) error {
+	n, err := getNotification(db, userId, notificationId)
+	if err != nil {
+		return err
+	}
+	if n.TimeRead.Valid {
+		return nil
+	}
+
+	n.TimeRead = null.TimeFrom(time.Now())
+	_, err = n.Update(context.Background(), db, boil.Infer())
+	return err
+}
+
+// MarkAllRead takes the ID of a user and marks all of their unread notifications as read
+func MarkAllRead(db *sql.DB, userId int) error {
+	_, err := models.Notifications(
+		models.NotificationWhere.UserToID.EQ(userId),
+		models.NotificationWhere.TimeRead.IsNull(),
+	).UpdateAll(context.Background(), db, models.M{models.NotificationColumns.TimeRead: time.Now()})
+	return err
+}
+
+// DeleteNotification takes the ID of a user and a notification and soft-deletes the notification, if it belongs to the user
+func DeleteNotification(db *sql.DB, userId, notificationId int) error {
+	n, err := getNotification(db, userId, notificationId)
+	if err != nil {
+		return err
+	}
+
+	_, err = n.Delete(context.Background(), db, false)
+	return err
+}
+
+// getNotification takes the ID of a user and a notification and returns the notification, if it belongs to the user
+func getNotification(db *sql.DB, userId, notificationId int) (*models.Notification, error) {
+	n, err := models.FindNotification(context.Background(), db, notificationId)
+	if err != nil {
+		return nil, err
+	}
+	if n.UserToID != userId {
+		return nil, errors.New("notification doesn't belong to this user")
+	}
+	return n, nil
+}
+
+// truncate cuts s off after max characters
+func truncate(s string, max int) string {
+	r := []rune(s)
+	if len(r) > max {
+		return string(r[:max])
+	}
+	return s
+}
@@ -0,0 +1,347 @@
+package preview
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"io"
+	"regexp"
+	"strconv"
+)
+
+var (
+	pdfRootPattern  = regexp.MustCompile(`/Root\s+(\d+)\s+(\d+)\s+R`)
+	pdfRefPattern   = regexp.MustCompile(`^(\d+)\s+(\d+)\s+R`)
+	pdfRefsPattern  = regexp.MustCompile(`(\d+)\s+(\d+)\s+R`)
+	pdfDrawPattern  = regexp.MustCompile(`/([^\s/<>\[\]()]+)\s+Do\b`)
+	pdfEntryPattern = regexp.MustCompile(`/([^\s/<>\[\]()]+)\s+(\d+)\s+(\d+)\s+R`)
+	pdfObjPattern   = regexp.MustCompile(`(?:^|[^\d])(\d+)\s+(\d+)\s+obj`)
+)
+
+const (
+	// maximum depth of the page tree, which stops following circular references
+	maxPageTreeDepth = 32
+	// maximum number of content streams and images of a page that are read
+	maxPDFRefs = 64
+)
+
+// pdfDocument is a PDF along with the positions of its objects
+type pdfDocument struct {
+	data []byte
+	// position following the header of each object, keyed by its number and generation
+	objects map[[2]int]int
+}
+
+// newPDFDocument indexes the objects of a PDF.
+// Updates of a document append new versions of objects, so the last definition of each object is used.
+func newPDFDocument(data []byte) *pdfDocument {
+	doc := &pdfDocument{data: data, objects: make(map[[2]int]int)}
+	for _, m := range pdfObjPattern.FindAllSubmatchIndex(data, -1) {
+		n, err1 := strconv.Atoi(string(data[m[2]:m[3]]))
+		g, err2 := strconv.Atoi(string(data[m[4]:m[5]]))
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		doc.objects[[2]int{n, g}] = m[1]
+	}
+	return doc
+}
+
+// firstPDFImage returns the first JPEG image drawn on the first page of a PDF, which is the page itself in scanned documents.
+// Only documents whose page tree isn't stored in compressed object streams can be read, other documents are unsupported.
+func firstPDFImage(data []byte) (image.Image, error) {
+	doc := newPDFDocument(data)
+	page, resources, err := doc.firstPage()
+	if err != nil {
+		return nil, err
+	}
+
+	// references are only resolved once an image is drawn
+	images := make(map[string][][]byte)
+	xobjects := doc.resolveDict(pdfValue(resources, "XObject"))
+	for _, m := range pdfEntryPattern.FindAllSubmatch(xobjects, -1) {
+		images[string(m[1])] = m[2:4]
+	}
+
+	content, err := doc.content(pdfValue(page, "Contents"))
+	if err != nil {
+		return nil, err
+	}
+
+	// images are tried in the order they are drawn
+	for _, m := range pdfDrawPattern.FindAllSubmatch(content, maxPDFRefs) {
+		ref, ok := images[string(m[1])]
+		if !ok {
+			continue
+		}
+		obj := doc.object(ref[0], ref[1])
+		dict := pdfDict(obj)
+		if !bytes.Equal(pdfValue(dict, "Subtype"), []byte("/Image")) || !bytes.Contains(pdfValue(dict, "Filter"), []byte("/DCTDecode")) {
+			continue
+		}
+
+		stream := pdfStream(obj)
+		conf, err := jpeg.DecodeConfig(bytes.NewReader(stream))
+		if err != nil || conf.Width*conf.Height > maxPixels {
+			continue
+		}
+		img, err := jpeg.Decode(bytes.NewReader(stream))
+		if err != nil {
+			continue
+		}
+		return img, nil
+	}
+
+	return nil, fmt.Errorf("%w: first page of PDF contains no JPEG image", ErrUnsupported)
+}
+
+// firstPage returns the dictionary of the first page of a PDF and its resources, which can be inherited from the page's ancestors
+func (doc *pdfDocument) firstPage() ([]byte, []byte, error) {
+	roots := pdfRootPattern.FindAllSubmatch(doc.data, -1)
+	if len(roots) == 0 {
+		return nil, nil, fmt.Errorf("%w: PDF has no readable catalog", ErrUnsupported)
+	}
+	// updates of a document append a new trailer, so the last one is current
+	root := roots[len(roots)-1]
+	catalog := pdfDict(doc.object(root[1], root[2]))
+
+	node := doc.resolveDict(pdfValue(catalog, "Pages"))
+	var resources []byte
+	for depth := 0; node != nil && depth < maxPageTreeDepth; depth++ {
+		if r := doc.resolveDict(pdfValue(node, "Resources")); r != nil {
+			resources = r
+		}
+		if bytes.Equal(pdfValue(node, "Type"), []byte("/Page")) {
+			return node, resources, nil
+		}
+
+		kids := pdfValue(node, "Kids")
+		if m := pdfRefPattern.FindSubmatch(kids); m != nil {
+			kids = doc.object(m[1], m[2])
+		}
+		first := pdfRefsPattern.FindSubmatch(kids)
+		if first == nil {
+			break
+		}
+		node = pdfDict(doc.object(first[1], first[2]))
+	}
+
+	return nil, nil, fmt.Errorf("%w: PDF has no readable first page", ErrUnsupported)
+}
+
+// content takes the value of the contents of a page, a reference to a stream or an array of them, and returns the decoded content, which is at most maxFileSize bytes long
+func (doc *pdfDocument) content(contents []byte) ([]byte, error) {
+	var content []byte
+	for _, m := range pdfRefsPattern.FindAllSubmatch(contents, maxPDFRefs) {
+		obj := doc.object(m[1], m[2])
+		dict := pdfDict(obj)
+		stream := pdfStream(obj)
+
+		switch filter := pdfValue(dict, "Filter"); {
+		case filter == nil:
+			end := bytes.Index(stream, []byte("endstream"))
+			if end < 0 {
+				return nil, fmt.Errorf("%w: unterminated content stream in PDF", ErrUnsupported)
+			}
+			if len(content)+end > maxFileSize {
+				return nil, fmt.Errorf("%w: content of first page of PDF is too large", ErrUnsupported)
+			}
+			content = append(content, stream[:end]...)
+		case bytes.Equal(bytes.Trim(filter, "[] \t\r\n"), []byte("/FlateDecode")):
+			// the decompressor stops at the end of the compressed data, so the length of the stream isn't needed
+			r, err := zlib.NewReader(bytes.NewReader(stream))
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid content stream in PDF: %s", ErrUnsupported, err.Error())
+			}
+			decoded, err := io.ReadAll(io.LimitReader(r, int64(maxFileSize-len(content))+1))
+			r.Close()
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid content stream in PDF: %s", ErrUnsupported, err.Error())
+			}
+			content = append(content, decoded...)
+		default:
+			return nil, fmt.Errorf("%w: content stream of PDF uses unsupported filters %s", ErrUnsupported, filter)
+		}
+		content = append(content, '\n')
+		if len(content) > maxFileSize {
+			return nil, fmt.Errorf("%w: content of first page of PDF is too large", ErrUnsupported)
+		}
+	}
+
+	return content, nil
+}
+
+// object returns the bytes following the header of an indirect object up to the end of the document, or nil if the object isn't found
+func (doc *pdfDocument) object(num, gen []byte) []byte {
+	n, err1 := strconv.Atoi(string(num))
+	g, err2 := strconv.Atoi(string(gen))
+	if err1 != nil || err2 != nil {
+		return nil
+	}
+
+	start, ok := doc.objects[[2]int{n, g}]
+	if !ok {
+		return nil
+	}
+	return doc.data[start:]
+}
+
+// resolveDict returns the dictionary a value is or refers to, or nil if it is neither
+func (doc *pdfDocument) resolveDict(value []byte) []byte {
+	if m := pdfRefPattern.FindSubmatch(value); m != nil {
+		return pdfDict(doc.object(m[1], m[2]))
+	}
+	return pdfDict(value)
+}
+
+// pdfStream returns the data of a stream object, which follows its dictionary
+func pdfStream(obj []byte) []byte {
+	start := pdfSkipSpace(obj, 0)
+	end := pdfValueEnd(obj, start)
+	if end < 0 {
+		return nil
+	}
+
+	b := obj[end:]
+	i := bytes.Index(b, []byte("stream"))
+	if i < 0 {
+		return nil
+	}
+
+	// the data starts after the end of line following "stream"
+	b = b[i+len("stream"):]
+	b = bytes.TrimPrefix(b, []byte("\r"))
+	return bytes.TrimPrefix(b, []byte("\n"))
+}
+
+// pdfDict returns the dictionary at the beginning of b, including its delimiters, or nil if there is none
+func pdfDict(b []byte) []byte {
+	start := pdfSkipSpace(b, 0)
+	if !bytes.HasPrefix(b[start:], []byte("<<")) {
+		return nil
+	}
+
+	end := pdfValueEnd(b, start)
+	if end < 0 {
+		return nil
+	}
+	return b[start:end]
+}
+
+// pdfValue returns the value of a key of a dictionary, or nil if the dictionary doesn't contain it. Keys of nested dictionaries are ignored.
+func pdfValue(dict []byte, key string) []byte {
+	if !bytes.HasPrefix(dict, []byte("<<")) {
+		return nil
+	}
+
+	for i := 2; ; {
+		i = pdfSkipSpace(dict, i)
+		if i >= len(dict) || dict[i] != '/' {
+			return nil
+		}
+		nameEnd := pdfValueEnd(dict, i)
+		if nameEnd < 0 {
+			return nil
+		}
+
+		start := pdfSkipSpace(dict, nameEnd)
+		end := pdfValueEnd(dict, start)
+		if end < 0 {
+			return nil
+		}
+		// a number followed by a generation and "R" is a reference
+		if m := pdfRefPattern.FindIndex(dict[start:]); m != nil {
+			end = start + m[1]
+		}
+
+		if string(dict[i+1:nameEnd]) == key {
+			return dict[start:end]
+		}
+		i = end
+	}
+}
+
+// pdfValueEnd returns the position following the value starting at i, or -1 if it isn't terminated
+func pdfValueEnd(b []byte, i int) int {
+	if i >= len(b) {
+		return -1
+	}
+
+	switch {
+	case bytes.HasPrefix(b[i:], []byte("<<")), b[i] == '[':
+		depth := 0
+		for j := i; j < len(b); {
+			switch {
+			case bytes.HasPrefix(b[j:], []byte("<<")):
+				depth++
+				j += 2
+			case bytes.HasPrefix(b[j:], []byte(">>")):
+				depth--
+				j += 2
+			case b[j] == '[':
+				depth++
+				j++
+			case b[j] == ']':
+				depth--
+				j++
+			case b[j] == '(' || b[j] == '<':
+				j = pdfValueEnd(b, j)
+				if j < 0 {
+					return -1
+				}
+			default:
+				j++
+			}
+			if depth == 0 {
+				return j
+			}
+		}
+		return -1
+	case b[i] == '(':
+		depth := 0
+		for j := i; j < len(b); j++ {
+			switch b[j] {
+			case '\\':
+				j++
+			case '(':
+				depth++
+			case ')':
+				depth--
+				if depth == 0 {
+					return j + 1
+				}
+			}
+		}
+		return -1
+	case b[i] == '<':
+		j := bytes.IndexByte(b[i:], '>')
+		if j < 0 {
+			return -1
+		}
+		return i + j + 1
+	}
+
+	// names, numbers and keywords end at whitespace or the next delimiter
+	j := i + 1
+	for j < len(b) && !pdfIsSpace(b[j]) && !bytes.ContainsAny(b[j:j+1], "/<>[]()") {
+		j++
+	}
+	return j
+}
+
+func pdfSkipSpace(b []byte, i int) int {
+	for i < len(b) && pdfIsSpace(b[i]) {
+		i++
+	}
+	return i
+}
+
+func pdfIsSpace(c byte) bool {
+	switch c {
+	case ' ', '\t', '\r', '\n', '\f', 0:
+		return true
+	}
+	return false
+}
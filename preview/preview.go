@@ -0,0 +1,128 @@
+// Package preview generates small preview images of uploaded images and PDFs in the background.
+// Previews are generated once per content, so files sharing their content share the preview as well.
+package preview
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"learningbay24.de/backend/models"
+	"learningbay24.de/backend/storage"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// MimeType is the MIME type of all previews
+const MimeType = "image/jpeg"
+
+// number of files waiting for their preview before further files are skipped
+const queueSize = 256
+
+type job struct {
+	hash     string
+	mimeType string
+}
+
+var queue chan job
+
+// Start starts the given number of workers generating previews for the files passed to Enqueue. Previews aren't generated before Start was called or if there are no workers.
+func Start(db *sql.DB, workers int) {
+	if workers <= 0 {
+		return
+	}
+
+	queue = make(chan job, queueSize)
+	for i := 0; i < workers; i++ {
+		go work(db)
+	}
+}
+
+// Supported reports whether previews can be generated for files of a MIME type
+func Supported(mimeType string) bool {
+	switch baseType(mimeType) {
+	case "image/jpeg", "image/png", "image/gif", "application/pdf":
+		return true
+	}
+	return false
+}
+
+// Enqueue takes the hash of the content of a file and its MIME type and generates a preview of it in the background, if previews are supported for the type.
+// If too many files are waiting already, the file is skipped.
+func Enqueue(hash, mimeType string) {
+	if queue == nil || !Supported(mimeType) {
+		return
+	}
+
+	select {
+	case queue <- job{hash, mimeType}:
+	default:
+		log.Warnf("Too many previews are being generated, skipping content %s", hash)
+	}
+}
+
+func work(db *sql.DB) {
+	for j := range queue {
+		if err := Create(db, j.hash, j.mimeType); err != nil {
+			log.Errorf("Unable to generate preview of content %s: %s", j.hash, err.Error())
+		}
+	}
+}
+
+// Create takes the hash of the content of a file and its MIME type, generates a preview of the content and stores it alongside the content.
+// Content that already has a preview or doesn't allow generating one is skipped.
+func Create(db *sql.DB, hash, mimeType string) error {
+	blob, err := models.FindFileBlob(context.Background(), db, hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		// all files with this content were removed meanwhile
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if blob.PreviewURI.Valid {
+		return nil
+	}
+
+	content, err := storage.Get(context.Background(), blob.URI)
+	if err != nil {
+		return err
+	}
+	img, err := Render(content, mimeType, blob.Size)
+	content.Close()
+	if errors.Is(err, ErrUnsupported) {
+		log.Debugf("No preview of content %s: %s", hash, err.Error())
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	uri, err := storage.Put(context.Background(), key(hash), bytes.NewReader(img), int64(len(img)))
+	if err != nil {
+		return err
+	}
+
+	res, err := db.Exec("UPDATE file_blob SET preview_uri = ? WHERE hash = ?", uri, hash)
+	if err != nil {
+		storage.Delete(context.Background(), uri)
+		return err
+	}
+	// the blob was removed while the preview was generated, nothing refers to the preview
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return storage.Delete(context.Background(), uri)
+	}
+
+	return nil
+}
+
+// key returns the key the preview of the content with the given hash is stored under
+func key(hash string) string {
+	return "previews/" + hash[:2] + "/" + hash + ".jpg"
+}
+
+// baseType strips parameters like the charset from a MIME type
+func baseType(mimeType string) string {
+	t, _, _ := strings.Cut(mimeType, ";")
+	return strings.TrimSpace(strings.ToLower(t))
+}
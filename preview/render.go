@@ -0,0 +1,132 @@
+package preview
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+)
+
+// ErrUnsupported is returned when no preview can be generated for a file
+var ErrUnsupported = errors.New("preview not supported")
+
+var tooLarge = fmt.Errorf("%w: file is too large", ErrUnsupported)
+
+const (
+	// maximum width and height of previews in pixels
+	Size = 256
+	// largest file previews are generated of, as it is held in memory
+	maxFileSize = 64 << 20
+	// largest image previews are generated of, in pixels, as decoding it takes 4 to 8 bytes per pixel
+	maxPixels = 50_000_000
+	quality   = 80
+)
+
+// Render takes the content of a file, its MIME type and size and returns a JPEG preview of at most Size pixels in width and height.
+// Images are scaled down. PDFs can't be rendered in pure Go, but scanned documents consist of JPEG images, so the first image drawn on the first page of a PDF is used if it is a JPEG.
+func Render(content io.Reader, mimeType string, size int64) ([]byte, error) {
+	var img image.Image
+	var err error
+
+	switch baseType(mimeType) {
+	case "image/jpeg", "image/png", "image/gif":
+		var data []byte
+		data, err = readAll(content, size, maxFileSize)
+		if err != nil {
+			return nil, err
+		}
+		img, err = decode(data)
+	case "application/pdf":
+		var data []byte
+		data, err = readAll(content, size, maxFileSize)
+		if err != nil {
+			return nil, err
+		}
+		img, err = firstPDFImage(data)
+	default:
+		return nil, fmt.Errorf("%w: files of type %s have no preview", ErrUnsupported, mimeType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = jpeg.Encode(&buf, thumbnail(img, Size), &jpeg.Options{Quality: quality})
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// readAll reads content of the given size, up to max bytes
+func readAll(content io.Reader, size, max int64) ([]byte, error) {
+	if size > max {
+		return nil, tooLarge
+	}
+	return io.ReadAll(io.LimitReader(content, max))
+}
+
+// decode decodes an image, rejecting images too large to be held in memory
+func decode(data []byte) (image.Image, error) {
+	conf, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupported, err.Error())
+	}
+	if conf.Width*conf.Height > maxPixels {
+		return nil, fmt.Errorf("%w: image has %dx%d pixels", ErrUnsupported, conf.Width, conf.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupported, err.Error())
+	}
+	return img, nil
+}
+
+// thumbnail scales an image down to fit into max by max pixels, averaging the pixels each pixel of the thumbnail covers.
+// Transparent parts are drawn on white, as JPEGs have no transparency.
+func thumbnail(src image.Image, max int) *image.RGBA {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	tw, th := w, h
+	if w > max || h > max {
+		if w >= h {
+			tw, th = max, h*max/w
+		} else {
+			tw, th = w*max/h, max
+		}
+	}
+	if tw < 1 {
+		tw = 1
+	}
+	if th < 1 {
+		th = 1
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, tw, th))
+	for y := 0; y < th; y++ {
+		y0, y1 := b.Min.Y+y*h/th, b.Min.Y+(y+1)*h/th
+		for x := 0; x < tw; x++ {
+			x0, x1 := b.Min.X+x*w/tw, b.Min.X+(x+1)*w/tw
+
+			var r, g, bl, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r, g, bl, a = r+uint64(cr), g+uint64(cg), bl+uint64(cb), a+uint64(ca)
+					n++
+				}
+			}
+
+			// colors are premultiplied with their alpha, adding the missing coverage draws them on white
+			white := 0xffff - a/n
+			dst.Set(x, y, color.RGBA64{uint16(r/n + white), uint16(g/n + white), uint16(bl/n + white), 0xffff})
+		}
+	}
+	return dst
+}
@@ -0,0 +1,133 @@
+package preview
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/stretchr/testify/assert"
+)
+
+func testImage(w, h int, c color.Color) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func renderedSize(t *testing.T, data []byte) image.Point {
+	img, err := jpeg.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return img.Bounds().Size()
+}
+
+func TestRenderImage(t *testing.T) {
+	var buf bytes.Buffer
+	png.Encode(&buf, testImage(1000, 500, color.RGBA{200, 0, 0, 255}))
+	data, err := Render(bytes.NewReader(buf.Bytes()), "image/png", int64(buf.Len()))
+	assert.NoError(t, err)
+	assert.Equal(t, image.Pt(Size, Size/2), renderedSize(t, data))
+
+	// small images aren't scaled up
+	buf.Reset()
+	png.Encode(&buf, testImage(20, 40, color.RGBA{200, 0, 0, 255}))
+	data, err = Render(bytes.NewReader(buf.Bytes()), "image/png", int64(buf.Len()))
+	assert.NoError(t, err)
+	assert.Equal(t, image.Pt(20, 40), renderedSize(t, data))
+}
+
+func TestThumbnailTransparent(t *testing.T) {
+	thumb := thumbnail(testImage(10, 10, color.Transparent), 5)
+	assert.Equal(t, color.RGBA{255, 255, 255, 255}, thumb.RGBAAt(2, 2))
+}
+
+func TestRenderPDF(t *testing.T) {
+	var scan bytes.Buffer
+	jpeg.Encode(&scan, testImage(600, 800, color.RGBA{0, 0, 200, 255}), nil)
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.AddPage()
+	pdf.RegisterImageOptionsReader("scan", fpdf.ImageOptions{ImageType: "JPG"}, &scan)
+	pdf.ImageOptions("scan", 0, 0, 210, 280, false, fpdf.ImageOptions{ImageType: "JPG"}, 0, "")
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := Render(bytes.NewReader(buf.Bytes()), "application/pdf", int64(buf.Len()))
+	assert.NoError(t, err)
+	assert.Equal(t, image.Pt(Size*600/800, Size), renderedSize(t, data))
+}
+
+func TestRenderPDFImageOnLaterPage(t *testing.T) {
+	var scan bytes.Buffer
+	jpeg.Encode(&scan, testImage(600, 800, color.RGBA{0, 0, 200, 255}), nil)
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.AddPage()
+	pdf.SetFont("Arial", "", 12)
+	pdf.Text(10, 10, "cover page")
+	pdf.AddPage()
+	pdf.RegisterImageOptionsReader("scan", fpdf.ImageOptions{ImageType: "JPG"}, &scan)
+	pdf.ImageOptions("scan", 0, 0, 210, 280, false, fpdf.ImageOptions{ImageType: "JPG"}, 0, "")
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := Render(bytes.NewReader(buf.Bytes()), "application/pdf", int64(buf.Len()))
+	assert.True(t, errors.Is(err, ErrUnsupported))
+}
+
+func TestRenderPDFRepeatedContent(t *testing.T) {
+	// a stream inflating to 4 MiB referenced by a page many times
+	var stream bytes.Buffer
+	w := zlib.NewWriter(&stream)
+	w.Write(make([]byte, 4<<20))
+	w.Close()
+
+	var pdf bytes.Buffer
+	pdf.WriteString("%PDF-1.4\n1 0 obj\n<< /Type /Catalog /Pages 2 0 R >>\nendobj\n")
+	pdf.WriteString("2 0 obj\n<< /Type /Pages /Kids [3 0 R] /Count 1 >>\nendobj\n")
+	pdf.WriteString("3 0 obj\n<< /Type /Page /Parent 2 0 R /Contents [" + strings.Repeat("4 0 R ", 10000) + "] >>\nendobj\n")
+	fmt.Fprintf(&pdf, "4 0 obj\n<< /Length %d /Filter /FlateDecode >>\nstream\n", stream.Len())
+	pdf.Write(stream.Bytes())
+	pdf.WriteString("\nendstream\nendobj\ntrailer\n<< /Root 1 0 R >>\n%%EOF\n")
+
+	_, err := Render(bytes.NewReader(pdf.Bytes()), "application/pdf", int64(pdf.Len()))
+	assert.True(t, errors.Is(err, ErrUnsupported))
+	assert.ErrorContains(t, err, "too large")
+}
+
+func TestRenderUnsupported(t *testing.T) {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.AddPage()
+	pdf.SetFont("Arial", "", 12)
+	pdf.Text(10, 10, "text only")
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := Render(bytes.NewReader(buf.Bytes()), "application/pdf", int64(buf.Len()))
+	assert.True(t, errors.Is(err, ErrUnsupported))
+
+	_, err = Render(strings.NewReader("notes"), "text/plain", 5)
+	assert.True(t, errors.Is(err, ErrUnsupported))
+
+	_, err = Render(strings.NewReader("not a png"), "image/png", 9)
+	assert.True(t, errors.Is(err, ErrUnsupported))
+}
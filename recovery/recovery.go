@@ -0,0 +1,179 @@
+// Package recovery lets users reset a forgotten password using a single-use token mailed to them
+package recovery
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+	"unicode/utf8"
+
+	"learningbay24.de/backend/config"
+	"learningbay24.de/backend/dbi"
+	"learningbay24.de/backend/mail"
+	"learningbay24.de/backend/models"
+	"learningbay24.de/backend/session"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// MailSender is used to send reset links. If it is nil, passwords can't be reset.
+var MailSender mail.Sender
+
+const (
+	// TokenLifetime is how long a reset link can be used
+	TokenLifetime = time.Hour
+	// no further links are sent to a user within this time after sending one, so nobody can flood a mailbox
+	resendInterval = 5 * time.Minute
+	// MinPasswordLength is the number of characters a new password needs at least
+	MinPasswordLength = 8
+)
+
+// ErrInvalidToken is returned for reset tokens that are unknown, expired or used already
+var ErrInvalidToken = errors.New("reset token is invalid or expired")
+
+// ErrPasswordTooShort is returned when a new password has less than MinPasswordLength characters
+var ErrPasswordTooShort = fmt.Errorf("passwords need at least %d characters", MinPasswordLength)
+
+type resetMail struct {
+	Name    string
+	URL     string
+	Minutes int
+}
+
+// RequestReset takes an email address and mails a link to reset the password to the user it belongs to.
+// Nothing happens if there is no such user, so callers mustn't reveal whether a mail was sent.
+func RequestReset(db *sql.DB, email string) error {
+	if MailSender == nil {
+		return errors.New("sending mails is disabled")
+	}
+	if config.Conf.Mail.ResetURL == "" {
+		return errors.New("no page to reset passwords is configured")
+	}
+	page, err := url.Parse(config.Conf.Mail.ResetURL)
+	if err != nil {
+		return fmt.Errorf("invalid page to reset passwords: %s", err.Error())
+	}
+
+	u, err := models.Users(models.UserWhere.Email.EQ(email)).One(context.Background(), db)
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Infof("Password reset requested for unknown email address")
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	recent, err := models.PasswordResets(
+		models.PasswordResetWhere.UserID.EQ(u.ID),
+		models.PasswordResetWhere.CreatedAt.GT(time.Now().Add(-resendInterval)),
+	).Exists(context.Background(), db)
+	if err != nil {
+		return err
+	}
+	if recent {
+		log.Infof("Password reset of user %d was requested again within %s, not sending another mail", u.ID, resendInterval)
+		return nil
+	}
+
+	token, hash, err := newToken()
+	if err != nil {
+		return err
+	}
+
+	r := &models.PasswordReset{UserID: u.ID, TokenHash: hash, ExpiresAt: time.Now().Add(TokenLifetime)}
+	err = r.Insert(context.Background(), db, boil.Infer())
+	if err != nil {
+		return err
+	}
+
+	query := page.Query()
+	query.Set("token", token)
+	page.RawQuery = query.Encode()
+	m, err := mail.Render(u.PreferredLanguageID, "password_reset", resetMail{Name: u.Firstname, URL: page.String(), Minutes: int(TokenLifetime.Minutes())})
+	if err != nil {
+		return err
+	}
+	m.To = u.Email
+
+	return MailSender.Send(m)
+}
+
+// Reset takes a reset token and a new password and sets it as the password of the user the token was sent to.
+// All reset tokens of the user become invalid and all of the user's sessions are ended, logging out whoever knew the old password.
+func Reset(db *sql.DB, token string, password []byte) error {
+	if utf8.RuneCount(password) < MinPasswordLength {
+		return ErrPasswordTooShort
+	}
+
+	tx, err := db.BeginTx(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+
+	r, err := models.PasswordResets(models.PasswordResetWhere.TokenHash.EQ(hashToken(token)), qm.For("UPDATE")).One(context.Background(), tx)
+	if err == nil && (r.UsedAt.Valid || time.Now().After(r.ExpiresAt)) {
+		err = ErrInvalidToken
+	} else if errors.Is(err, sql.ErrNoRows) {
+		err = ErrInvalidToken
+	}
+	if err != nil {
+		if e := tx.Rollback(); e != nil {
+			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+		}
+		return err
+	}
+
+	err = dbi.SetPassword(tx, r.UserID, password)
+	if err == nil {
+		_, err = tx.Exec("UPDATE password_reset SET used_at = ? WHERE user_id = ? AND used_at IS NULL", time.Now(), r.UserID)
+	}
+	if err == nil {
+		_, err = session.RevokeAll(tx, r.UserID)
+	}
+	if err != nil {
+		if e := tx.Rollback(); e != nil {
+			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+		}
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	log.Infof("User %d reset their password", r.UserID)
+	return nil
+}
+
+// RemoveExpired removes reset tokens that can't be used anymore and returns how many were removed
+func RemoveExpired(db *sql.DB) (int64, error) {
+	res, err := db.Exec("DELETE FROM password_reset WHERE expires_at < ?", time.Now())
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// newToken returns a random token to be mailed and the hash of it to be stored
+func newToken() (string, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return token, hashToken(token), nil
+}
+
+func hashToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
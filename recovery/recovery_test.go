@@ -0,0 +1,142 @@
+package recovery
+
+import (
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"learningbay24.de/backend/config"
+	"learningbay24.de/backend/dbi"
+	"learningbay24.de/backend/mail"
+)
+
+// hashArg remembers the token hash stored by RequestReset
+type hashArg struct {
+	hash string
+}
+
+// Match satisfies sqlmock.Argument interface
+func (a *hashArg) Match(v driver.Value) bool {
+	s, ok := v.(string)
+	a.hash = s
+	return ok && len(s) == 64
+}
+
+const resetURL = "https://learningbay24.de/password/reset"
+
+func TestRequestResetWithoutPage(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+
+	MailSender = &mail.MemorySender{}
+	defer func() { MailSender = nil }()
+
+	// links would lead nowhere, so no token is created
+	assert.Error(t, RequestReset(db, "ada@learningbay24.de"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRequestResetUnknownEmail(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+
+	sender := &mail.MemorySender{}
+	MailSender = sender
+	defer func() { MailSender = nil }()
+	config.Conf.Mail.ResetURL = resetURL
+	defer func() { config.Conf.Mail.ResetURL = "" }()
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `user`")).WithArgs("nobody@learningbay24.de").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	assert.NoError(t, RequestReset(db, "nobody@learningbay24.de"))
+	assert.Empty(t, sender.Sent())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRequestReset(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+
+	sender := &mail.MemorySender{}
+	MailSender = sender
+	defer func() { MailSender = nil }()
+	config.Conf.Mail.ResetURL = resetURL
+	defer func() { config.Conf.Mail.ResetURL = "" }()
+
+	stored := &hashArg{}
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `user`")).WithArgs("ada@learningbay24.de").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "firstname", "email", "preferred_language_id"}).AddRow(1, "Ada", "ada@learningbay24.de", dbi.EnglishLanguageId))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `password_reset`")).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `password_reset`")).WithArgs(1, stored, sqlmock.AnyArg(), sqlmock.AnyArg(), nil).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	assert.NoError(t, RequestReset(db, "ada@learningbay24.de"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	sent := sender.Sent()
+	if !assert.Len(t, sent, 1) {
+		return
+	}
+	assert.Equal(t, "ada@learningbay24.de", sent[0].To)
+	assert.Contains(t, sent[0].Body, "Hello Ada,")
+
+	// only the hash of the mailed token is stored
+	_, link, found := strings.Cut(sent[0].Body, resetURL+"?token=")
+	if !assert.True(t, found) {
+		return
+	}
+	token, err := url.QueryUnescape(strings.Fields(link)[0])
+	assert.NoError(t, err)
+	h := sha256.Sum256([]byte(token))
+	assert.Equal(t, hex.EncodeToString(h[:]), stored.hash)
+	assert.NotContains(t, stored.hash, token)
+}
+
+func TestResetInvalidToken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+
+	// unknown
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `password_reset`")).WithArgs(hashToken("unknown")).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectRollback()
+	assert.True(t, errors.Is(Reset(db, "unknown", []byte("correct horse")), ErrInvalidToken))
+
+	// used already
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `password_reset`")).WithArgs(hashToken("used")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "expires_at", "used_at"}).AddRow(1, 1, time.Now().Add(time.Hour), time.Now()))
+	mock.ExpectRollback()
+	assert.True(t, errors.Is(Reset(db, "used", []byte("correct horse")), ErrInvalidToken))
+
+	// expired
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `password_reset`")).WithArgs(hashToken("expired")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "expires_at", "used_at"}).AddRow(1, 1, time.Now().Add(-time.Minute), nil))
+	mock.ExpectRollback()
+	assert.True(t, errors.Is(Reset(db, "expired", []byte("correct horse")), ErrInvalidToken))
+
+	// short passwords are rejected before looking at the token
+	assert.True(t, errors.Is(Reset(db, "unknown", []byte("short")), ErrPasswordTooShort))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,141 @@
+package scanner
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// size of the chunks the content is sent to clamd in
+const chunkSize = 64 << 10
+
+// Clamd scans files using a ClamAV daemon. The content is streamed to it with the INSTREAM command, so the daemon doesn't need access to the files.
+type Clamd struct {
+	network string
+	address string
+	timeout time.Duration
+}
+
+// NewClamd takes the address of a ClamAV daemon, either "host:port" or "unix:" followed by the path of its socket, and the time a scan may take at most. A timeout of 0 means no limit.
+func NewClamd(address string, timeout time.Duration) *Clamd {
+	if strings.HasPrefix(address, "unix:") {
+		return &Clamd{network: "unix", address: strings.TrimPrefix(address, "unix:"), timeout: timeout}
+	}
+	return &Clamd{network: "tcp", address: address, timeout: timeout}
+}
+
+// Ping checks whether the daemon can be reached
+func (c *Clamd) Ping(ctx context.Context) error {
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err = conn.Write([]byte("zPING\x00")); err != nil {
+		return err
+	}
+
+	reply, err := readReply(conn)
+	if err != nil {
+		return err
+	}
+	if reply != "PONG" {
+		return fmt.Errorf("unexpected reply from clamd: %s", reply)
+	}
+	return nil
+}
+
+func (c *Clamd) Scan(ctx context.Context, r io.Reader) error {
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	err = sendStream(conn, r)
+	// clamd closes the connection when the stream exceeds its StreamMaxLength, its reply tells why
+	reply, replyErr := readReply(conn)
+	if replyErr != nil {
+		if err != nil {
+			return err
+		}
+		return replyErr
+	}
+
+	return parseReply(reply)
+}
+
+func (c *Clamd) dial(ctx context.Context) (net.Conn, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, c.network, c.address)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline, ok := ctx.Deadline()
+	if c.timeout != 0 && (!ok || time.Now().Add(c.timeout).Before(deadline)) {
+		deadline, ok = time.Now().Add(c.timeout), true
+	}
+	if ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	return conn, nil
+}
+
+// sendStream sends the INSTREAM command followed by the content of r, each chunk prefixed by its length. A chunk of length 0 ends the stream.
+func sendStream(w io.Writer, r io.Reader) error {
+	if _, err := w.Write([]byte("zINSTREAM\x00")); err != nil {
+		return err
+	}
+
+	buf := make([]byte, 4+chunkSize)
+	for {
+		n, err := io.ReadFull(r, buf[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(buf, uint32(n))
+			if _, err := w.Write(buf[:4+n]); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+
+	_, err := w.Write([]byte{0, 0, 0, 0})
+	return err
+}
+
+// readReply reads a reply terminated by a null byte, as requested by prefixing commands with "z"
+func readReply(r io.Reader) (string, error) {
+	reply, err := bufio.NewReader(r).ReadString(0)
+	if err != nil && !(errors.Is(err, io.EOF) && reply != "") {
+		return "", err
+	}
+	return strings.TrimSuffix(reply, "\x00"), nil
+}
+
+// parseReply interprets the reply to a scan, which is "stream: OK", "stream: <signature> FOUND" or an error message ending in "ERROR"
+func parseReply(reply string) error {
+	result := strings.TrimPrefix(reply, "stream: ")
+	if result == "OK" {
+		return nil
+	}
+	if strings.HasSuffix(result, " FOUND") {
+		return fmt.Errorf("%w with %s", ErrInfected, strings.TrimSuffix(result, " FOUND"))
+	}
+	return fmt.Errorf("clamd failed to scan file: %s", reply)
+}
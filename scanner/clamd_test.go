@@ -0,0 +1,129 @@
+package scanner
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// serveClamd answers like a ClamAV daemon detecting the EICAR test file and returns the address it listens on.
+// Streams longer than maxLength are rejected like clamd does when they exceed its StreamMaxLength.
+func serveClamd(t *testing.T, maxLength int) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go handleClamd(conn, maxLength)
+		}
+	}()
+
+	return l.Addr().String()
+}
+
+func handleClamd(conn net.Conn, maxLength int) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+
+	cmd, err := r.ReadString(0)
+	if err != nil {
+		return
+	}
+	switch cmd {
+	case "zPING\x00":
+		conn.Write([]byte("PONG\x00"))
+		return
+	case "zINSTREAM\x00":
+	default:
+		conn.Write([]byte("UNKNOWN COMMAND\x00"))
+		return
+	}
+
+	var content bytes.Buffer
+	for {
+		var size uint32
+		if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+			return
+		}
+		if size == 0 {
+			break
+		}
+		if content.Len()+int(size) > maxLength {
+			conn.Write([]byte("INSTREAM size limit exceeded. ERROR\x00"))
+			return
+		}
+		if _, err := io.CopyN(&content, r, int64(size)); err != nil {
+			return
+		}
+	}
+
+	if bytes.Contains(content.Bytes(), []byte(Eicar)) {
+		conn.Write([]byte("stream: Eicar-Test-Signature FOUND\x00"))
+	} else {
+		conn.Write([]byte("stream: OK\x00"))
+	}
+}
+
+func TestClamd(t *testing.T) {
+	c := NewClamd(serveClamd(t, 1<<20), 5*time.Second)
+	ctx := context.Background()
+
+	assert.NoError(t, c.Ping(ctx))
+	assert.NoError(t, c.Scan(ctx, strings.NewReader("lecture notes")))
+	assert.NoError(t, c.Scan(ctx, strings.NewReader("")))
+
+	err := c.Scan(ctx, strings.NewReader("lecture notes "+Eicar))
+	assert.True(t, errors.Is(err, ErrInfected))
+	assert.Contains(t, err.Error(), "Eicar-Test-Signature")
+
+	// the signature spans two chunks
+	content := strings.Repeat("x", chunkSize-10) + Eicar
+	assert.True(t, errors.Is(c.Scan(ctx, strings.NewReader(content)), ErrInfected))
+
+	err = c.Scan(ctx, strings.NewReader(strings.Repeat("x", 2<<20)))
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, ErrInfected))
+}
+
+func TestClamdUnreachable(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+
+	c := NewClamd(addr, time.Second)
+	assert.Error(t, c.Ping(context.Background()))
+
+	// files are rejected rather than saved unscanned
+	err = c.Scan(context.Background(), strings.NewReader("lecture notes"))
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, ErrInfected))
+}
+
+func TestFake(t *testing.T) {
+	f := NewFake()
+	assert.NoError(t, f.Scan(context.Background(), strings.NewReader("lecture notes")))
+	assert.True(t, errors.Is(f.Scan(context.Background(), strings.NewReader(Eicar)), ErrInfected))
+	assert.Equal(t, 2, f.Scanned)
+
+	f.Err = errors.New("connection refused")
+	assert.Equal(t, f.Err, f.Scan(context.Background(), strings.NewReader("lecture notes")))
+}
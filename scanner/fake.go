@@ -0,0 +1,45 @@
+package scanner
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+)
+
+// Eicar is the EICAR test file, which virus scanners detect as malware although it is harmless
+const Eicar = `X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*`
+
+// Fake is a scanner for tests, which detects malware by looking for the byte sequences of its signatures. It isn't safe for concurrent use.
+type Fake struct {
+	// names of signatures by the byte sequences identifying them
+	Signatures map[string]string
+	// Err is returned instead of scanning if set, like when the daemon can't be reached
+	Err error
+	// number of files scanned
+	Scanned int
+}
+
+// NewFake returns a fake scanner detecting the EICAR test file
+func NewFake() *Fake {
+	return &Fake{Signatures: map[string]string{Eicar: "Eicar-Test-Signature"}}
+}
+
+func (f *Fake) Scan(ctx context.Context, r io.Reader) error {
+	if f.Err != nil {
+		return f.Err
+	}
+
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	f.Scanned++
+
+	for seq, name := range f.Signatures {
+		if bytes.Contains(content, []byte(seq)) {
+			return fmt.Errorf("%w with %s", ErrInfected, name)
+		}
+	}
+	return nil
+}
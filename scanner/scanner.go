@@ -0,0 +1,56 @@
+// Package scanner checks the content of uploaded files for malware before they are saved, as other users download them later.
+package scanner
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"learningbay24.de/backend/config"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrInfected is returned when malware was found in the content of a file
+var ErrInfected = errors.New("file is infected")
+
+// Scanner checks the content of files for malware
+type Scanner interface {
+	// Scan reads the content of r and returns an error wrapping ErrInfected if it contains malware
+	Scan(ctx context.Context, r io.Reader) error
+}
+
+// Nop is the scanner used if no virus scanner is configured, it accepts every file
+type Nop struct{}
+
+func (Nop) Scan(ctx context.Context, r io.Reader) error {
+	return nil
+}
+
+var current Scanner = Nop{}
+
+// Init sets up the scanner from the config. Without a configured daemon, files aren't scanned.
+func Init() {
+	if config.Conf.Scanner.Clamd == "" {
+		Set(Nop{})
+		return
+	}
+
+	c := NewClamd(config.Conf.Scanner.Clamd, time.Duration(config.Conf.Scanner.TimeoutSeconds)*time.Second)
+	// uploads are rejected while the daemon can't be reached, but it may just be starting up
+	if err := c.Ping(context.Background()); err != nil {
+		log.Warnf("Unable to reach clamd at %s: %s", config.Conf.Scanner.Clamd, err.Error())
+	}
+	Set(c)
+}
+
+// Set replaces the scanner used by Scan
+func Set(s Scanner) {
+	current = s
+}
+
+// Scan checks the content of r using the configured scanner
+func Scan(ctx context.Context, r io.Reader) error {
+	return current.Scan(ctx, r)
+}
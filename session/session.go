@@ -0,0 +1,269 @@
+// Package session keeps track of the logins of users. A login is kept alive by a refresh token, which is replaced by a new one whenever it is used.
+// Revoking a session makes the access tokens issued for it invalid right away.
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"learningbay24.de/backend/config"
+	"learningbay24.de/backend/models"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var (
+	// ErrInvalid is returned for sessions and refresh tokens that are unknown, expired or revoked
+	ErrInvalid = errors.New("session is invalid")
+	// ErrRefreshedConcurrently is returned for a refresh token that was replaced moments ago by another request of the same client, which keeps the session
+	ErrRefreshedConcurrently = errors.New("session was refreshed concurrently")
+)
+
+const (
+	defaultAccessTokenLifetime = 15 * time.Minute
+	defaultLifetime            = 30 * 24 * time.Hour
+	// how often the last time a session was seen is updated
+	touchInterval = time.Minute
+	// time after refreshing a session during which the replaced refresh token is rejected without revoking the session, as clients may refresh concurrently
+	reuseGracePeriod = 10 * time.Second
+	// maximum length of the device stored with a session
+	maxDeviceLength = 256
+)
+
+// AccessTokenLifetime returns how long access tokens are valid, as configured in Sessions
+func AccessTokenLifetime() time.Duration {
+	if config.Conf.Sessions.AccessTokenMinutes == 0 {
+		return defaultAccessTokenLifetime
+	}
+	return time.Duration(config.Conf.Sessions.AccessTokenMinutes) * time.Minute
+}
+
+// Lifetime returns how long a session lasts without being refreshed, as configured in Sessions
+func Lifetime() time.Duration {
+	if config.Conf.Sessions.RefreshTokenDays == 0 {
+		return defaultLifetime
+	}
+	return time.Duration(config.Conf.Sessions.RefreshTokenDays) * 24 * time.Hour
+}
+
+// Create takes the ID of a user logging in and the device and IP address used, and returns the new session and its refresh token
+func Create(db *sql.DB, userId int, device, ip string) (*models.Session, string, error) {
+	token, hash, err := newToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	s := &models.Session{
+		UserID:           userId,
+		RefreshTokenHash: hash,
+		Device:           truncate(device, maxDeviceLength),
+		IP:               ip,
+		LastSeenAt:       now,
+		RefreshedAt:      now,
+		ExpiresAt:        now.Add(Lifetime()),
+	}
+	err = s.Insert(context.Background(), db, boil.Infer())
+	if err != nil {
+		return nil, "", err
+	}
+
+	return s, token, nil
+}
+
+// Refresh takes a refresh token and the device and IP address using it, and returns its session along with the refresh token replacing it.
+// A refresh token that was replaced already was most likely stolen, so its session is revoked,
+// unless it was replaced within the grace period, in which case ErrRefreshedConcurrently is returned.
+func Refresh(db *sql.DB, token, device, ip string) (*models.Session, string, error) {
+	hash := hashToken(token)
+
+	tx, err := db.BeginTx(context.Background(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	s, err := models.Sessions(models.SessionWhere.RefreshTokenHash.EQ(hash), qm.For("UPDATE")).One(context.Background(), tx)
+	if errors.Is(err, sql.ErrNoRows) {
+		concurrent, err := revokeReused(tx, hash)
+		if err != nil {
+			if e := tx.Rollback(); e != nil {
+				return nil, "", fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+			}
+			return nil, "", err
+		}
+		if err = tx.Commit(); err != nil {
+			return nil, "", err
+		}
+		if concurrent {
+			return nil, "", ErrRefreshedConcurrently
+		}
+		return nil, "", ErrInvalid
+	} else if err != nil {
+		if e := tx.Rollback(); e != nil {
+			return nil, "", fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+		}
+		return nil, "", err
+	}
+
+	if !active(s) {
+		tx.Rollback()
+		return nil, "", ErrInvalid
+	}
+
+	refreshToken, refreshHash, err := newToken()
+	if err != nil {
+		tx.Rollback()
+		return nil, "", err
+	}
+
+	now := time.Now()
+	s.PreviousTokenHash = null.StringFrom(s.RefreshTokenHash)
+	s.RefreshTokenHash = refreshHash
+	s.Device = truncate(device, maxDeviceLength)
+	s.IP = ip
+	s.LastSeenAt = now
+	s.RefreshedAt = now
+	s.ExpiresAt = now.Add(Lifetime())
+	_, err = s.Update(context.Background(), tx, boil.Infer())
+	if err != nil {
+		if e := tx.Rollback(); e != nil {
+			return nil, "", fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+		}
+		return nil, "", err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, "", err
+	}
+	return s, refreshToken, nil
+}
+
+// revokeReused revokes the session a replaced refresh token belonged to, if hash is the hash of one.
+// It returns true instead if the token was replaced within the grace period and its session is still active.
+func revokeReused(tx *sql.Tx, hash string) (bool, error) {
+	s, err := models.Sessions(models.SessionWhere.PreviousTokenHash.EQ(null.StringFrom(hash)), qm.For("UPDATE")).One(context.Background(), tx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	if !active(s) {
+		return false, nil
+	}
+	if time.Since(s.RefreshedAt) < reuseGracePeriod {
+		return true, nil
+	}
+
+	log.Warnf("Replaced refresh token of session %d of user %d was used again, revoking the session", s.ID, s.UserID)
+	s.RevokedAt = null.TimeFrom(time.Now())
+	_, err = s.Update(context.Background(), tx, boil.Whitelist(models.SessionColumns.RevokedAt))
+	return false, err
+}
+
+// Validate takes the ID of a session, the user it belongs to and the IP address using it and returns ErrInvalid unless the session is active.
+// The time the session was last seen is updated along the way.
+func Validate(db *sql.DB, id, userId int, ip string) error {
+	s, err := models.FindSession(context.Background(), db, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrInvalid
+	} else if err != nil {
+		return err
+	}
+	if s.UserID != userId || !active(s) {
+		return ErrInvalid
+	}
+
+	// don't write on every request
+	if time.Since(s.LastSeenAt) < touchInterval && s.IP == ip {
+		return nil
+	}
+	s.LastSeenAt = time.Now()
+	s.IP = ip
+	_, err = s.Update(context.Background(), db, boil.Whitelist(models.SessionColumns.LastSeenAt, models.SessionColumns.IP))
+	return err
+}
+
+// List takes the ID of a user and returns the user's active sessions, most recently seen first
+func List(db *sql.DB, userId int) (models.SessionSlice, error) {
+	return models.Sessions(
+		models.SessionWhere.UserID.EQ(userId),
+		models.SessionWhere.RevokedAt.IsNull(),
+		models.SessionWhere.ExpiresAt.GT(time.Now()),
+		qm.OrderBy(models.SessionColumns.LastSeenAt+" DESC"),
+	).All(context.Background(), db)
+}
+
+// Revoke takes the ID of a session and the user it belongs to and ends it. Sessions of other users can't be found.
+func Revoke(db *sql.DB, id, userId int) error {
+	res, err := db.Exec("UPDATE session SET revoked_at = ? WHERE id = ? AND user_id = ? AND revoked_at IS NULL", time.Now(), id, userId)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// RevokeAll takes the ID of a user and ends all of the user's sessions within exec. It returns how many sessions were active.
+func RevokeAll(exec boil.ContextExecutor, userId int) (int64, error) {
+	res, err := exec.ExecContext(context.Background(), "UPDATE session SET revoked_at = ? WHERE user_id = ? AND revoked_at IS NULL AND expires_at > ?", time.Now(), userId, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// RemoveExpired removes sessions which expired or were revoked longer than the lifetime of access tokens ago and returns how many were removed
+func RemoveExpired(db *sql.DB) (int64, error) {
+	deadline := time.Now().Add(-AccessTokenLifetime())
+	res, err := db.Exec("DELETE FROM session WHERE expires_at < ? OR revoked_at < ?", deadline, deadline)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func active(s *models.Session) bool {
+	return !s.RevokedAt.Valid && s.ExpiresAt.After(time.Now())
+}
+
+// newToken returns a random refresh token and its hash, which is stored instead of the token itself
+func newToken() (string, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return token, hashToken(token), nil
+}
+
+func hashToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+// truncate shortens s to at most n characters
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
@@ -0,0 +1,129 @@
+package session
+
+import (
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+var sessionColumns = []string{"id", "user_id", "refresh_token_hash", "previous_token_hash", "device", "ip", "created_at", "last_seen_at", "refreshed_at", "expires_at", "revoked_at"}
+
+// sessionRows returns a session of user 2 that was refreshed at refreshedAt
+func sessionRows(refreshedAt, expiresAt time.Time, revokedAt driver.Value) *sqlmock.Rows {
+	return sqlmock.NewRows(sessionColumns).
+		AddRow(1, 2, hashToken("current"), hashToken("previous"), "browser", "127.0.0.1", refreshedAt, time.Now(), refreshedAt, expiresAt, revokedAt)
+}
+
+func TestRefresh(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `session` WHERE (`session`.`refresh_token_hash` = ?)")).WithArgs(hashToken("current")).
+		WillReturnRows(sessionRows(time.Now().Add(-time.Hour), time.Now().Add(time.Hour), nil))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `session` SET")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	s, token, err := Refresh(db, "current", "app", "127.0.0.2")
+	assert.NoError(t, err)
+	assert.NotEqual(t, "current", token)
+	assert.Equal(t, hashToken(token), s.RefreshTokenHash)
+	assert.Equal(t, hashToken("current"), s.PreviousTokenHash.String)
+	assert.Equal(t, "127.0.0.2", s.IP)
+	assert.WithinDuration(t, time.Now(), s.RefreshedAt, time.Second)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRefreshInactive(t *testing.T) {
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		revokedAt driver.Value
+	}{
+		{"expired", time.Now().Add(-time.Minute), nil},
+		{"revoked", time.Now().Add(time.Hour), time.Now().Add(-time.Minute)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected", err)
+			}
+			defer db.Close()
+
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta("FROM `session` WHERE (`session`.`refresh_token_hash` = ?)")).WithArgs(hashToken("current")).
+				WillReturnRows(sessionRows(time.Now().Add(-time.Hour), tt.expiresAt, tt.revokedAt))
+			mock.ExpectRollback()
+
+			_, _, err = Refresh(db, "current", "app", "127.0.0.1")
+			assert.True(t, errors.Is(err, ErrInvalid))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRefreshReused(t *testing.T) {
+	tests := []struct {
+		name        string
+		refreshedAt time.Time
+		revokedAt   driver.Value
+		revoke      bool
+		err         error
+	}{
+		// another tab of the same browser refreshed the session moments ago
+		{"within grace period", time.Now().Add(-time.Second), nil, false, ErrRefreshedConcurrently},
+		{"after grace period", time.Now().Add(-time.Minute), nil, true, ErrInvalid},
+		{"revoked session", time.Now().Add(-time.Second), time.Now().Add(-time.Minute), false, ErrInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected", err)
+			}
+			defer db.Close()
+
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta("FROM `session` WHERE (`session`.`refresh_token_hash` = ?)")).WithArgs(hashToken("previous")).
+				WillReturnRows(sqlmock.NewRows(sessionColumns))
+			mock.ExpectQuery(regexp.QuoteMeta("FROM `session` WHERE (`session`.`previous_token_hash` = ?)")).WithArgs(hashToken("previous")).
+				WillReturnRows(sessionRows(tt.refreshedAt, time.Now().Add(time.Hour), tt.revokedAt))
+			if tt.revoke {
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE `session` SET `revoked_at`=? WHERE `id`=?")).WithArgs(sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+			mock.ExpectCommit()
+
+			_, _, err = Refresh(db, "previous", "app", "127.0.0.1")
+			assert.True(t, errors.Is(err, tt.err))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRefreshUnknown(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `session` WHERE (`session`.`refresh_token_hash` = ?)")).WillReturnRows(sqlmock.NewRows(sessionColumns))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `session` WHERE (`session`.`previous_token_hash` = ?)")).WillReturnRows(sqlmock.NewRows(sessionColumns))
+	mock.ExpectCommit()
+
+	_, _, err = Refresh(db, "unknown", "app", "127.0.0.1")
+	assert.True(t, errors.Is(err, ErrInvalid))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,104 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Local stores files in a directory on the local disk
+type Local struct {
+	Root string
+}
+
+// NewLocal returns a backend storing files below root
+func NewLocal(root string) *Local {
+	return &Local{Root: root}
+}
+
+func (l *Local) Put(ctx context.Context, key string, r io.Reader, size int64) error {
+	p, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return err
+	}
+
+	fp, err := os.Create(p)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(fp, r)
+	if e := fp.Close(); err == nil {
+		err = e
+	}
+	if err != nil {
+		// don't leave partially written files behind
+		os.Remove(p)
+		return err
+	}
+
+	return nil
+}
+
+func (l *Local) Get(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	p, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	fp, err := os.Open(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotExist
+	} else if err != nil {
+		return nil, err
+	}
+	return fp, nil
+}
+
+func (l *Local) Delete(ctx context.Context, key string) error {
+	p, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(p)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (l *Local) Stat(ctx context.Context, key string) (*Info, error) {
+	p, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	fi, err := os.Stat(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotExist
+	} else if err != nil {
+		return nil, err
+	}
+	return &Info{Size: fi.Size(), ModTime: fi.ModTime()}, nil
+}
+
+// path returns the path on disk of a key. Keys have to be relative and can't leave the root.
+func (l *Local) path(key string) (string, error) {
+	clean := path.Clean(filepath.ToSlash(key))
+	if filepath.IsAbs(key) || path.IsAbs(clean) || clean == "." || clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", fmt.Errorf("invalid key \"%s\"", key)
+	}
+
+	return filepath.Join(l.Root, filepath.FromSlash(clean)), nil
+}
@@ -0,0 +1,80 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"learningbay24.de/backend/config"
+)
+
+// S3 stores files in a bucket of an S3 compatible object storage, like MinIO
+type S3 struct {
+	client *minio.Client
+	bucket string
+}
+
+// NewS3 connects to the object storage configured in conf and creates the bucket, if it doesn't exist yet
+func NewS3(conf config.S3) (*S3, error) {
+	client, err := minio.New(conf.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(conf.AccessKey, conf.SecretKey, ""),
+		Secure: conf.Secure,
+		Region: conf.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := client.BucketExists(context.Background(), conf.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("unable to reach bucket \"%s\": %w", conf.Bucket, err)
+	}
+	if !exists {
+		err = client.MakeBucket(context.Background(), conf.Bucket, minio.MakeBucketOptions{Region: conf.Region})
+		if err != nil {
+			return nil, fmt.Errorf("unable to create bucket \"%s\": %w", conf.Bucket, err)
+		}
+	}
+
+	return &S3{client: client, bucket: conf.Bucket}, nil
+}
+
+func (s *S3) Put(ctx context.Context, key string, r io.Reader, size int64) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, size, minio.PutObjectOptions{})
+	return err
+}
+
+func (s *S3) Get(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, s.mapError(err)
+	}
+
+	// the object is only requested on first use, so check that it exists now
+	if _, err := obj.Stat(); err != nil {
+		obj.Close()
+		return nil, s.mapError(err)
+	}
+	return obj, nil
+}
+
+func (s *S3) Delete(ctx context.Context, key string) error {
+	return s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+}
+
+func (s *S3) Stat(ctx context.Context, key string) (*Info, error) {
+	oi, err := s.client.StatObject(ctx, s.bucket, key, minio.StatObjectOptions{})
+	if err != nil {
+		return nil, s.mapError(err)
+	}
+	return &Info{Size: oi.Size, ModTime: oi.LastModified}, nil
+}
+
+func (s *S3) mapError(err error) error {
+	if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+		return ErrNotExist
+	}
+	return err
+}
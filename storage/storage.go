@@ -0,0 +1,150 @@
+// Package storage stores the content of files in one of several backends.
+// The URI of a stored file names the backend that owns it, followed by the key of the file inside that backend, e.g. "s3:lecture.pdf".
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"learningbay24.de/backend/config"
+)
+
+// ErrNotExist is returned when a file doesn't exist in its backend
+var ErrNotExist = errors.New("file does not exist")
+
+// Storage is a backend the content of files is stored in. Keys are slash separated paths.
+type Storage interface {
+	// Put stores the content of r under key. size is the length of the content or -1 if it is unknown.
+	Put(ctx context.Context, key string, r io.Reader, size int64) error
+	// Get returns the content stored under key, which has to be closed after use
+	Get(ctx context.Context, key string) (io.ReadSeekCloser, error)
+	// Delete removes the content stored under key. Deleting a key that doesn't exist is no error.
+	Delete(ctx context.Context, key string) error
+	// Stat describes the content stored under key, it returns ErrNotExist if there is none
+	Stat(ctx context.Context, key string) (*Info, error)
+}
+
+// Info describes the content stored under a key
+type Info struct {
+	Size    int64
+	ModTime time.Time
+}
+
+var (
+	backends       = map[string]Storage{}
+	defaultBackend string
+)
+
+// Init sets up the backends from the config. New files are stored in the backend set by Files.Storage.
+// Every configured backend is set up, so files stored before switching to another backend stay available.
+func Init() error {
+	Register("local", NewLocal(config.Conf.Files.Path))
+
+	if config.Conf.S3.Endpoint != "" {
+		s, err := NewS3(config.Conf.S3)
+		if err != nil {
+			return err
+		}
+		Register("s3", s)
+	}
+
+	switch config.Conf.Files.Storage {
+	case "":
+		defaultBackend = "local"
+	case "local", "s3":
+		if _, ok := backends[config.Conf.Files.Storage]; !ok {
+			return fmt.Errorf("storage backend \"%s\" isn't configured", config.Conf.Files.Storage)
+		}
+		defaultBackend = config.Conf.Files.Storage
+	default:
+		return fmt.Errorf("unknown storage backend \"%s\"", config.Conf.Files.Storage)
+	}
+
+	return nil
+}
+
+// Register makes a backend available under name. The first registered backend becomes the default one, unless Init sets another one.
+func Register(name string, s Storage) {
+	backends[name] = s
+	if defaultBackend == "" {
+		defaultBackend = name
+	}
+}
+
+// DefaultURI returns the URI a file stored under key in the default backend has
+func DefaultURI(key string) string {
+	return defaultBackend + ":" + key
+}
+
+// Put stores the content of r under key in the default backend and returns the URI of the stored file
+func Put(ctx context.Context, key string, r io.Reader, size int64) (string, error) {
+	s, _, err := backend(DefaultURI(key))
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.Put(ctx, key, r, size); err != nil {
+		return "", err
+	}
+	return DefaultURI(key), nil
+}
+
+// Get takes the URI of a file and returns its content, which has to be closed after use
+func Get(ctx context.Context, uri string) (io.ReadSeekCloser, error) {
+	s, key, err := backend(uri)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(ctx, key)
+}
+
+// Delete takes the URI of a file and removes its content from its backend
+func Delete(ctx context.Context, uri string) error {
+	s, key, err := backend(uri)
+	if err != nil {
+		return err
+	}
+	return s.Delete(ctx, key)
+}
+
+// Stat takes the URI of a file and describes its content
+func Stat(ctx context.Context, uri string) (*Info, error) {
+	s, key, err := backend(uri)
+	if err != nil {
+		return nil, err
+	}
+	return s.Stat(ctx, key)
+}
+
+// legacyKey takes the URI of a file stored before there were backends, which is its path on disk below Files.Path, and returns its key in the local backend
+func legacyKey(uri string) (string, error) {
+	rel, err := filepath.Rel(filepath.Clean(config.Conf.Files.Path), filepath.Clean(uri))
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("file \"%s\" isn't stored below %s", uri, config.Conf.Files.Path)
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+// backend takes the URI of a file and returns the backend owning it and the key of the file in the backend
+func backend(uri string) (Storage, string, error) {
+	name, key, found := strings.Cut(uri, ":")
+	if !found || strings.HasPrefix(uri, "/") {
+		var err error
+		name = "local"
+		key, err = legacyKey(uri)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	s, ok := backends[name]
+	if !ok {
+		return nil, "", fmt.Errorf("storage backend \"%s\" isn't set up", name)
+	}
+	return s, key, nil
+}
@@ -0,0 +1,39 @@
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"learningbay24.de/backend/config"
+)
+
+func TestLocalPath(t *testing.T) {
+	l := NewLocal("/var/lib/learningbay24")
+
+	p, err := l.path("blobs/ab/abcdef")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.FromSlash("/var/lib/learningbay24/blobs/ab/abcdef"), p)
+
+	for _, key := range []string{"/etc/passwd", "../secret", "blobs/../../secret", ".", ""} {
+		_, err := l.path(key)
+		assert.Error(t, err, key)
+	}
+}
+
+func TestBackendLegacyURI(t *testing.T) {
+	oldConf, oldBackends := config.Conf, backends
+	defer func() { config.Conf, backends = oldConf, oldBackends }()
+
+	for _, root := range []string{"/var/lib/learningbay24/", "files"} {
+		config.Conf.Files.Path = root
+		backends = map[string]Storage{"local": NewLocal(root)}
+
+		_, key, err := backend(filepath.Join(root, "lecture.pdf"))
+		assert.NoError(t, err, root)
+		assert.Equal(t, "lecture.pdf", key, root)
+
+		_, _, err = backend("/etc/passwd")
+		assert.Error(t, err, root)
+	}
+}
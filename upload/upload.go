@@ -0,0 +1,291 @@
+// Package upload keeps track of resumable uploads using the tus protocol.
+// The bytes of an upload are received in any number of requests, possibly by different instances, and kept as parts in the storage backend until the complete file is attached to its target.
+package upload
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+	"unicode/utf8"
+
+	"learningbay24.de/backend/models"
+	"learningbay24.de/backend/storage"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// what a complete upload can be attached to
+const (
+	// material of a course, target ID is the course
+	TargetMaterial = "material"
+	// file of an exam, target ID is the exam
+	TargetExam = "exam"
+	// answer to an exam, target ID is the exam
+	TargetAnswer = "answer"
+	// file of a user submission, target ID is the user submission
+	TargetSubmission = "submission"
+)
+
+// Expiry is how long uploads are kept after they were created
+const Expiry = 24 * time.Hour
+
+// a lock held longer than this is taken to be left behind by a request that never finished, e.g. because its instance stopped
+const lockTimeout = time.Hour
+
+// ErrOffsetMismatch is returned when bytes are appended at another offset than the number of bytes received so far
+var ErrOffsetMismatch = errors.New("offset doesn't match the number of bytes received")
+
+// ErrLocked is returned when bytes are appended to an upload while another request is appending to it
+var ErrLocked = errors.New("upload is in use by another request")
+
+// partKey returns the key the part of an upload starting at offset is stored under
+func partKey(id string, offset int64) string {
+	return fmt.Sprintf("uploads/%s/%d", id, offset)
+}
+
+// Create takes the uploading user, what the file is attached to once it is complete, the name of the file and its size in bytes, and starts an upload
+func Create(db *sql.DB, userId int, target string, targetId int, fileName string, length int64) (*models.Upload, error) {
+	switch target {
+	case TargetMaterial, TargetExam, TargetAnswer, TargetSubmission:
+	default:
+		return nil, fmt.Errorf("invalid target \"%s\"", target)
+	}
+	if fileName == "" || utf8.RuneCountInString(fileName) > 64 {
+		return nil, fmt.Errorf("file names have to be between 1 and 64 characters long")
+	}
+	if length < 0 {
+		return nil, fmt.Errorf("invalid length %d", length)
+	}
+
+	u := &models.Upload{ID: uuid.NewString(), UserID: userId, Target: target, TargetID: targetId, FileName: fileName, Length: length}
+	err := u.Insert(context.Background(), db, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// Get takes the ID of an upload and the user it belongs to and returns it. Uploads of other users can't be found.
+func Get(db *sql.DB, id string, userId int) (*models.Upload, error) {
+	u, err := models.FindUpload(context.Background(), db, id)
+	if err != nil {
+		return nil, err
+	}
+	if u.UserID != userId {
+		return nil, sql.ErrNoRows
+	}
+
+	return u, nil
+}
+
+// Lock reserves an upload for the calling request, so concurrent requests can't append to it at the same time, even if they reach different instances.
+// The returned function releases it. sql.ErrNoRows is returned if the upload doesn't exist.
+func Lock(db *sql.DB, id string) (func(), error) {
+	now := time.Now()
+	res, err := db.Exec("UPDATE upload SET locked_at = ? WHERE id = ? AND (locked_at IS NULL OR locked_at < ?)", now, id, now.Add(-lockTimeout))
+	if err != nil {
+		return nil, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if n == 0 {
+		exists, err := models.UploadExists(context.Background(), db, id)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, sql.ErrNoRows
+		}
+		return nil, ErrLocked
+	}
+
+	return func() {
+		if _, err := db.Exec("UPDATE upload SET locked_at = NULL WHERE id = ?", id); err != nil {
+			log.Errorf("Unable to unlock upload %s: %s", id, err.Error())
+		}
+	}, nil
+}
+
+// Append takes an upload, the offset the client continues at and the following bytes and stores them as the next part of the upload, until it is complete.
+// The bytes received before reading r failed are kept, so the client can resume from there.
+func Append(db *sql.DB, u *models.Upload, offset int64, r io.Reader) error {
+	if offset != u.UploadOffset {
+		return fmt.Errorf("%w: expected %d, got %d", ErrOffsetMismatch, u.UploadOffset, offset)
+	}
+
+	// the bytes are spooled to a temporary file first, as backends need to know the size of what they store
+	fp, err := os.CreateTemp("", "learningbay24-part-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		fp.Close()
+		os.Remove(fp.Name())
+	}()
+
+	n, copyErr := io.Copy(fp, io.LimitReader(r, u.Length-u.UploadOffset))
+	if n == 0 {
+		return copyErr
+	}
+	if _, err = fp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	uri, err := storage.Put(context.Background(), partKey(u.ID, offset), fp, n)
+	if err != nil {
+		return err
+	}
+
+	err = addPart(db, u, &models.UploadPart{UploadID: u.ID, Offset: offset, URI: uri, Size: n})
+	if err != nil {
+		storage.Delete(context.Background(), uri)
+		return err
+	}
+
+	return copyErr
+}
+
+// addPart records a stored part of an upload and moves the upload's offset behind it
+func addPart(db *sql.DB, u *models.Upload, part *models.UploadPart) error {
+	tx, err := db.BeginTx(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+
+	err = part.Insert(context.Background(), tx, boil.Infer())
+	if err == nil {
+		u.UploadOffset += part.Size
+		_, err = u.Update(context.Background(), tx, boil.Whitelist(models.UploadColumns.UploadOffset))
+	}
+	if err != nil {
+		u.UploadOffset = part.Offset
+		if e := tx.Rollback(); e != nil {
+			return fmt.Errorf("fatal: unable to rollback transaction on error: %s; %s", err.Error(), e.Error())
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Complete reports whether all bytes of an upload were received
+func Complete(u *models.Upload) bool {
+	return u.UploadOffset == u.Length
+}
+
+// Open returns the received bytes of an upload, which have to be closed after use
+func Open(db *sql.DB, u *models.Upload) (io.ReadCloser, error) {
+	parts, err := u.UploadParts(qm.OrderBy(models.UploadPartColumns.Offset+" ASC")).All(context.Background(), db)
+	if err != nil {
+		return nil, err
+	}
+
+	return &partsReader{parts: parts}, nil
+}
+
+// Finish marks an upload as attached to its target and removes its bytes, which are stored with the file now
+func Finish(db *sql.DB, u *models.Upload) error {
+	u.FinishedAt = null.TimeFrom(time.Now())
+	_, err := u.Update(context.Background(), db, boil.Whitelist(models.UploadColumns.FinishedAt))
+	if err != nil {
+		return err
+	}
+
+	return removeParts(db, u)
+}
+
+// Remove takes an upload and removes it along with the bytes received
+func Remove(db *sql.DB, u *models.Upload) error {
+	if err := removeParts(db, u); err != nil {
+		return err
+	}
+
+	_, err := u.Delete(context.Background(), db)
+	return err
+}
+
+// RemoveExpired removes all uploads created longer than Expiry ago and returns how many were removed
+func RemoveExpired(db *sql.DB) (int, error) {
+	uploads, err := models.Uploads(models.UploadWhere.CreatedAt.LT(time.Now().Add(-Expiry))).All(context.Background(), db)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, u := range uploads {
+		if err := Remove(db, u); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(uploads), nil
+}
+
+// removeParts removes the bytes received for an upload
+func removeParts(db *sql.DB, u *models.Upload) error {
+	parts, err := u.UploadParts().All(context.Background(), db)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range parts {
+		if err := storage.Delete(context.Background(), p.URI); err != nil {
+			return err
+		}
+	}
+
+	_, err = parts.DeleteAll(context.Background(), db)
+	return err
+}
+
+// partsReader reads the parts of an upload one after another, opening each part only once it is reached
+type partsReader struct {
+	parts   models.UploadPartSlice
+	current io.ReadCloser
+}
+
+func (r *partsReader) Read(p []byte) (int, error) {
+	for {
+		if r.current == nil {
+			if len(r.parts) == 0 {
+				return 0, io.EOF
+			}
+
+			content, err := storage.Get(context.Background(), r.parts[0].URI)
+			if err != nil {
+				return 0, err
+			}
+			r.current = content
+			r.parts = r.parts[1:]
+		}
+
+		n, err := r.current.Read(p)
+		if errors.Is(err, io.EOF) {
+			r.current.Close()
+			r.current = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+func (r *partsReader) Close() error {
+	if r.current == nil {
+		return nil
+	}
+	return r.current.Close()
+}
@@ -0,0 +1,180 @@
+package upload
+
+import (
+	"database/sql"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"learningbay24.de/backend/models"
+	"learningbay24.de/backend/storage"
+)
+
+const id = "0b7e5a4c-1d2f-4e3a-9b8c-7d6e5f4a3b2c"
+
+// setupStorage registers a temporary local backend and returns the directory it stores files in
+func setupStorage(t *testing.T) string {
+	dir := t.TempDir()
+	storage.Register("local", storage.NewLocal(dir))
+	return dir
+}
+
+func TestAppendOffsetMismatch(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+
+	u := &models.Upload{ID: id, Length: 10, UploadOffset: 4}
+	err = Append(db, u, 0, strings.NewReader("lecture"))
+	assert.True(t, errors.Is(err, ErrOffsetMismatch))
+
+	// nothing was received
+	assert.Equal(t, int64(4), u.UploadOffset)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAppend(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+	dir := setupStorage(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `upload_part`")).WithArgs(id, int64(4), "local:uploads/"+id+"/4", int64(6)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `upload` SET `upload_offset`=? WHERE `id`=?")).WithArgs(int64(10), id).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// bytes beyond the length of the upload are ignored
+	u := &models.Upload{ID: id, Length: 10, UploadOffset: 4}
+	assert.NoError(t, Append(db, u, 4, strings.NewReader(" notes and more")))
+	assert.Equal(t, int64(10), u.UploadOffset)
+	assert.True(t, Complete(u))
+
+	part, err := os.ReadFile(filepath.Join(dir, "uploads", id, "4"))
+	assert.NoError(t, err)
+	assert.Equal(t, " notes", string(part))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAppendFailedRecord(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+	dir := setupStorage(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `upload_part`")).WillReturnError(errors.New("connection lost"))
+	mock.ExpectRollback()
+
+	u := &models.Upload{ID: id, Length: 10, UploadOffset: 4}
+	assert.Error(t, Append(db, u, 4, strings.NewReader(" notes")))
+
+	// the client resumes at the same offset, so the stored part is removed again
+	assert.Equal(t, int64(4), u.UploadOffset)
+	assert.NoFileExists(t, filepath.Join(dir, "uploads", id, "4"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLock(t *testing.T) {
+	tests := []struct {
+		name   string
+		locked int64
+		exists int
+		err    error
+	}{
+		{"unlocked", 1, 0, nil},
+		{"locked by another request", 0, 1, ErrLocked},
+		{"missing", 0, 0, sql.ErrNoRows},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected", err)
+			}
+			defer db.Close()
+
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE upload SET locked_at = ? WHERE id = ? AND (locked_at IS NULL OR locked_at < ?)")).
+				WithArgs(sqlmock.AnyArg(), id, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, tt.locked))
+			if tt.locked == 0 {
+				mock.ExpectQuery(regexp.QuoteMeta("from `upload`")).WithArgs(id).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(tt.exists))
+			}
+
+			unlock, err := Lock(db, id)
+			assert.True(t, errors.Is(err, tt.err))
+			if tt.err == nil {
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE upload SET locked_at = NULL WHERE id = ?")).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+				unlock()
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestOpen(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+	dir := setupStorage(t)
+
+	if err := os.MkdirAll(filepath.Join(dir, "uploads", id), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	os.WriteFile(filepath.Join(dir, "uploads", id, "0"), []byte("lecture"), 0o644)
+	os.WriteFile(filepath.Join(dir, "uploads", id, "7"), []byte(" notes"), 0o644)
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `upload_part`")).WithArgs(id).WillReturnRows(sqlmock.NewRows([]string{"upload_id", "offset", "uri", "size"}).
+		AddRow(id, 0, "local:uploads/"+id+"/0", 7).
+		AddRow(id, 7, "local:uploads/"+id+"/7", 6))
+
+	content, err := Open(db, &models.Upload{ID: id, Length: 13, UploadOffset: 13})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer content.Close()
+
+	// the parts are read one after another
+	data, err := io.ReadAll(content)
+	assert.NoError(t, err)
+	assert.Equal(t, "lecture notes", string(data))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRemove(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected", err)
+	}
+	defer db.Close()
+	dir := setupStorage(t)
+
+	part := filepath.Join(dir, "uploads", id, "0")
+	if err := os.MkdirAll(filepath.Dir(part), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	os.WriteFile(part, []byte("lecture"), 0o644)
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM `upload_part`")).WithArgs(id).WillReturnRows(sqlmock.NewRows([]string{"upload_id", "offset", "uri", "size"}).
+		AddRow(id, 0, "local:uploads/"+id+"/0", 7))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `upload_part`")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `upload` WHERE `id`=?")).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, Remove(db, &models.Upload{ID: id, Length: 13, UploadOffset: 7}))
+	assert.NoFileExists(t, part)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}